@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -12,11 +14,15 @@ import (
 
 	"quran-api-go/internal/config"
 	"quran-api-go/internal/database"
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/graphqlserver"
 	"quran-api-go/internal/handler"
 	"quran-api-go/internal/mcpserver"
 	"quran-api-go/internal/middleware"
 	"quran-api-go/internal/repository"
 	"quran-api-go/internal/service"
+	"quran-api-go/pkg/daily"
+	"quran-api-go/pkg/response"
 	_ "quran-api-go/docs"
 )
 
@@ -62,6 +68,7 @@ import (
 // @description     | `get_ayahs_by_surah` | Ayat-ayat dalam surah tertentu |
 // @description     | `get_ayah` | Ayat berdasarkan ID global |
 // @description     | `get_ayah_by_ref` | Ayat berdasarkan nomor surah dan ayat |
+// @description     | `get_ayahs_by_reference` | Ayat berdasarkan referensi bebas (`2:255`, `QS 2:1-5`, `yasin 1-12`) |
 // @description     | `random_ayah` | Ayat acak |
 // @description     | `list_juz` | Daftar semua 30 juz |
 // @description     | `get_juz` | Detail juz tertentu |
@@ -78,7 +85,7 @@ import (
 // @description
 // @description     > *"Surah apa saja yang ada di Juz 30?"*
 // @host            localhost:8080
-// @BasePath        /
+// @BasePath        /v1
 // @schemes         http https
 func main() {
 	cfg := config.Load()
@@ -95,11 +102,18 @@ func main() {
 	}()
 
 	r := gin.New()
+	r.Use(middleware.RequestID())
 	r.Use(middleware.Recovery())
 	r.Use(middleware.Logging())
 	if cfg.AllowedOrigins != "" {
 		r.Use(middleware.CORS(cfg.AllowedOrigins))
 	}
+	r.Use(middleware.Language())
+	r.Use(middleware.Compress(middleware.CompressOptions{
+		MinSize:      1024,
+		CacheEntries: 512,
+		ExcludePaths: []string{"/mcp"}, // streamable HTTP must not be buffered
+	}))
 
 	healthCheckRepo := repository.NewHealthCheckRepository(db)
 	healthCheckService := service.NewHealthCheckService(healthCheckRepo)
@@ -110,12 +124,33 @@ func main() {
 	ayahRepo := repository.NewAyahRepository(db)
 	ayahService := service.NewAyahService(ayahRepo)
 	ayahHandler := handler.NewAyahHandler(ayahService, surahService)
+	referenceHandler := handler.NewReferenceHandler(ayahService, surahService)
+	citationHandler := handler.NewCitationHandler()
+	cardHandler := handler.NewCardHandler(ayahService)
+	shareHandler := handler.NewShareHandler(ayahService)
+	dailyZone, err := time.LoadLocation(cfg.DailyTimezone)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid DAILY_TIMEZONE")
+	}
+	var dailyPool daily.Pool
+	if cfg.DailyAyahs != "" {
+		if dailyPool, err = daily.ParsePool(cfg.DailyAyahs); err != nil {
+			log.Fatal().Err(err).Msg("invalid DAILY_AYAHS")
+		}
+	}
+	dailyHandler := handler.NewDailyHandler(ayahService, surahService, dailyZone, dailyPool)
+	planRepo := repository.NewPlanRepository(db)
+	planService := service.NewPlanService(planRepo)
+	planHandler := handler.NewPlanHandler(planService, dailyZone)
 	juzRepo := repository.NewJuzRepository(db)
 	juzService := service.NewJuzService(juzRepo)
-	juzHandler := handler.NewJuzHandler(juzService)
+	juzHandler := handler.NewJuzHandler(juzService, surahService)
 	searchRepo := repository.NewSearchRepository(db)
 	searchService := service.NewSearchService(searchRepo)
-	searchHandler := handler.NewSearchHandler(searchService)
+	searchHandler := handler.NewSearchHandler(searchService, surahService)
+	statsRepo := repository.NewStatsRepository(db)
+	statsService := service.NewStatsService(statsRepo)
+	statsHandler := handler.NewStatsHandler(statsService, surahService)
 	docsHandler := handler.NewDocsHandler()
 
 	mcpSrv := mcpserver.New(cfg.AppVersion, surahService, ayahService, juzService, searchService)
@@ -123,21 +158,93 @@ func main() {
 		return mcpSrv
 	}, &mcp.StreamableHTTPOptions{Stateless: true})
 
+	gqlSrv, err := graphqlserver.New(surahService, ayahService, juzService, searchService, graphqlserver.Limits{
+		MaxDepth:      cfg.GraphQLMaxDepth,
+		MaxComplexity: cfg.GraphQLMaxComplexity,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to build graphql schema")
+	}
+
+	// The Quran data only changes when the database is re-seeded, so data
+	// and docs responses carry validators keyed on the dataset version.
+	// /random and /health are never cached; /daily and plans starting today
+	// expire at midnight.
+	datasetModTime := time.Time{}
+	if info, err := os.Stat(cfg.DBPath); err == nil {
+		datasetModTime = info.ModTime()
+	}
+	datasetVersion := cfg.DatasetVersion
+	if datasetVersion == "" {
+		datasetVersion = strconv.FormatInt(datasetModTime.Unix(), 10)
+	}
+	dataCache := middleware.Cache(middleware.CacheOptions{
+		Version:      datasetVersion,
+		LastModified: datasetModTime,
+		CacheControl: cfg.CacheControl,
+	})
+	docs := r.Group("", middleware.Cache(middleware.CacheOptions{
+		Version:      cfg.AppVersion,
+		CacheControl: cfg.DocsCacheControl,
+	}))
+
 	r.GET("/", func(c *gin.Context) { c.Redirect(301, "/docs") })
 	r.GET("/health", healthCheckHandler.HealthCheck)
 	r.GET("/health/ready", healthCheckHandler.ReadyCheck)
-	r.GET("/surah", surahHandler.List)
-	r.GET("/surah/:id", surahHandler.Detail)
-	r.GET("/ayah/:id", ayahHandler.Detail)
-	r.GET("/surah/:id/ayah", ayahHandler.BySurah)
-	r.GET("/surah/:id/ayah/:number", ayahHandler.BySurahAndNumber)
-	r.GET("/random", ayahHandler.RandomAyah)
-	r.GET("/sajda", ayahHandler.Sajda)
-	r.GET("/juz", juzHandler.List)
-	r.GET("/juz/:number", juzHandler.Detail)
-	r.GET("/juz/:number/ayah", juzHandler.Ayahs)
-	r.GET("/juz/:number/surah", juzHandler.Surahs)
-	r.GET("/search", searchHandler.Search)
+	// Share links are public URLs rather than API calls, so they stay
+	// unversioned.
+	r.GET("/s/:surah/:ayah", dataCache, shareHandler.Page)
+	r.GET("/oembed", dataCache, shareHandler.OEmbed)
+
+	// The REST API is served under /v1. The unversioned paths remain as
+	// aliases for existing clients and, once a deprecation date is
+	// configured, point to their /v1 successor.
+	registerAPI := func(api *gin.RouterGroup) {
+		data := api.Group("", dataCache)
+		data.GET("/surah", surahHandler.List)
+		data.GET("/surah/search", surahHandler.Search)
+		data.GET("/surah/:id", surahHandler.Detail)
+		data.GET("/ayah", ayahHandler.List)
+		data.GET("/ayah/:id", ayahHandler.Detail)
+		data.GET("/ayah/:id/context", ayahHandler.Context)
+		data.GET("/ayah/:id/cite", citationHandler.Cite)
+		data.GET("/ayah/:id/card.svg", cardHandler.Card)
+		api.POST("/ayah/batch", ayahHandler.Batch)
+		data.GET("/surah/:id/ayah", ayahHandler.BySurah)
+		data.GET("/surah/:id/ayah/:number", ayahHandler.BySurahAndNumber)
+		data.GET("/surah/:id/stats", statsHandler.Surah)
+		data.GET("/ref", referenceHandler.Resolve)
+		data.GET("/range", ayahHandler.Range)
+		api.GET("/random", ayahHandler.RandomAyah)
+		api.GET("/daily", dailyHandler.Daily)               // sets its own Cache-Control
+		api.GET("/plans/khatam", planHandler.Khatam)        // likewise
+		api.GET("/plans/khatam.ics", planHandler.KhatamICS) // likewise
+		data.GET("/sajda", ayahHandler.Sajda)
+		data.GET("/juz", juzHandler.List)
+		data.GET("/juz/:number", juzHandler.Detail)
+		data.GET("/juz/:number/ayah", juzHandler.Ayahs)
+		data.GET("/juz/:number/surah", juzHandler.Surahs)
+		data.GET("/juz/:number/stats", statsHandler.Juz)
+		data.GET("/stats", statsHandler.Overview)
+		data.GET("/search", searchHandler.Search)
+	}
+	v1 := r.Group("/v1")
+	registerAPI(v1)
+	// The OpenAPI basePath is /v1, so the unversioned endpoints above are
+	// served there too.
+	v1.GET("/health", healthCheckHandler.HealthCheck)
+	v1.GET("/health/ready", healthCheckHandler.ReadyCheck)
+	v1.GET("/s/:surah/:ayah", dataCache, shareHandler.Page)
+	v1.GET("/oembed", dataCache, shareHandler.OEmbed)
+	unversioned := r.Group("")
+	if cfg.UnversionedDeprecation != "" {
+		unversioned.Use(middleware.Deprecation(middleware.DeprecationOptions{
+			Since:     parseDate("UNVERSIONED_DEPRECATION", cfg.UnversionedDeprecation),
+			Sunset:    parseDate("UNVERSIONED_SUNSET", cfg.UnversionedSunset),
+			Successor: middleware.VersionSuccessor("/v1"),
+		}))
+	}
+	registerAPI(unversioned)
 
 	// MCP endpoint with per-route CORS so browser-based clients (MCP Inspector,
 	// Claude.ai web, etc.) work regardless of the global ALLOWED_ORIGINS value.
@@ -150,10 +257,18 @@ func main() {
 	r.POST("/mcp", mcpCORS, gin.WrapH(mcpHandler))
 	r.GET("/mcp", mcpCORS, gin.WrapH(mcpHandler))
 
+	// GraphQL answers depend on the query body, so it bypasses the ETag cache.
+	r.GET("/graphql", gin.WrapH(gqlSrv))
+	r.POST("/graphql", gin.WrapH(gqlSrv))
+
 	// Documentation
-	r.GET("/docs", docsHandler.ServeDocs)
-	r.GET("/openapi.yaml", docsHandler.ServeOpenAPI)
-	r.GET("/static/:filename", docsHandler.ServeStatic)
+	docs.GET("/docs", docsHandler.ServeDocs)
+	docs.GET("/openapi.yaml", docsHandler.ServeOpenAPI)
+	docs.GET("/static/:filename", docsHandler.ServeStatic)
+
+	r.NoRoute(func(c *gin.Context) {
+		response.NotFound(c, domain.CodeRouteNotFound, "route not found")
+	})
 
 	addr := fmt.Sprintf("%s:%s", cfg.ServerHost, cfg.ServerPort)
 	log.Info().Str("addr", addr).Msg("starting server")
@@ -162,6 +277,18 @@ func main() {
 	}
 }
 
+// parseDate reads a YYYY-MM-DD setting; empty yields the zero time.
+func parseDate(name, value string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		log.Fatal().Err(err).Str("setting", name).Msg("invalid date, want YYYY-MM-DD")
+	}
+	return t
+}
+
 func setupLogger(level string) {
 	lvl, err := zerolog.ParseLevel(level)
 	if err != nil {
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"quran-api-go/scripts/fontsubset"
+)
+
+func main() {
+	log.Logger = zerolog.New(os.Stdout).With().Timestamp().Logger()
+
+	in := flag.String("in", "", "source TrueType font")
+	out := flag.String("out", "internal/handler/static/fonts/amiri-arabic.woff", "WOFF output path")
+	flag.Parse()
+
+	font, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to read font")
+	}
+
+	sfnt, err := fontsubset.Subset(font, fontsubset.Arabic)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to subset font")
+	}
+	woff, err := fontsubset.WOFF(sfnt)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to write WOFF")
+	}
+	if err := os.WriteFile(*out, woff, 0o644); err != nil {
+		log.Fatal().Err(err).Msg("failed to write font")
+	}
+
+	log.Info().Int("source_bytes", len(font)).Int("subset_bytes", len(woff)).Str("out", *out).Msg("font subset written")
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/reflection"
+
+	"quran-api-go/internal/config"
+	"quran-api-go/internal/database"
+	"quran-api-go/internal/grpcserver"
+	"quran-api-go/internal/repository"
+	"quran-api-go/internal/service"
+)
+
+func main() {
+	cfg := config.Load()
+
+	lvl, err := zerolog.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		lvl = zerolog.InfoLevel
+	}
+	zerolog.SetGlobalLevel(lvl)
+	log.Logger = zerolog.New(os.Stdout).With().Timestamp().Logger()
+
+	db, err := database.New(cfg.DBPath)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to connect database")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.Error().Err(err).Msg("failed to close database")
+		}
+	}()
+
+	healthCheckRepo := repository.NewHealthCheckRepository(db)
+	healthCheckSvc := service.NewHealthCheckService(healthCheckRepo)
+	surahRepo := repository.NewSurahRepository(db)
+	surahSvc := service.NewSurahService(surahRepo)
+	ayahRepo := repository.NewAyahRepository(db)
+	ayahSvc := service.NewAyahService(ayahRepo)
+	juzRepo := repository.NewJuzRepository(db)
+	juzSvc := service.NewJuzService(juzRepo)
+	searchRepo := repository.NewSearchRepository(db)
+	searchSvc := service.NewSearchService(searchRepo)
+
+	srv := grpcserver.New(surahSvc, ayahSvc, juzSvc, searchSvc, healthCheckSvc)
+	// Reflection lets grpcurl and similar tools discover the API without the
+	// .proto files.
+	reflection.Register(srv)
+
+	addr := net.JoinHostPort(cfg.ServerHost, cfg.GRPCPort)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatal().Err(err).Str("addr", addr).Msg("failed to listen")
+	}
+
+	go func() {
+		stop := make(chan os.Signal, 1)
+		signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+		<-stop
+		log.Info().Msg("stopping gRPC server")
+		srv.GracefulStop()
+	}()
+
+	log.Info().Str("addr", addr).Msg("starting gRPC server")
+	if err := srv.Serve(lis); err != nil {
+		log.Fatal().Err(err).Msg("gRPC server stopped")
+	}
+}
@@ -15,35 +15,1096 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/ayah": {
+            "get": {
+                "description": "Ayahs matching every given filter, e.g. the Meccan ayahs of juz 30 (juz=30\u0026revelation=meccan). Without filters it pages through the whole mushaf. sort orders by a field, descending with a leading \"-\"; ties keep mushaf order.",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson",
+                    "text/markdown",
+                    "text/plain"
+                ],
+                "tags": [
+                    "Ayah"
+                ],
+                "summary": "Query ayahs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Surah number (1-114) or name",
+                        "name": "surah",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Juz number",
+                        "name": "juz",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "meccan",
+                            "medinan"
+                        ],
+                        "type": "string",
+                        "description": "Revelation type",
+                        "name": "revelation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only sajda ayahs (true) or none of them (false)",
+                        "name": "sajda",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Minimum number of words",
+                        "name": "min_words",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "-id",
+                            "words",
+                            "-words",
+                            "letters",
+                            "-letters"
+                        ],
+                        "type": "string",
+                        "default": "id",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Ayahs per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ndjson",
+                            "markdown",
+                            "text"
+                        ],
+                        "type": "string",
+                        "description": "Output format (overrides Accept)",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.AyahListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "RFC 8288 links to the first, prev, next and last pages"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ayah/batch": {
+            "post": {
+                "description": "Get up to 100 ayahs in one request by global ID or surah:ayah key. Ayahs are returned in request order, one entry per requested id; an ayah missing from the dataset is null.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ayah"
+                ],
+                "summary": "Get ayahs in batch",
+                "parameters": [
+                    {
+                        "description": "Ayah IDs or surah:ayah keys",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AyahBatchRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/handler.AyahDetailResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/ayah/{id}": {
             "get": {
-                "description": "Get a specific ayah by its global ID (1-6236)",
+                "description": "Get a specific ayah by its global ID (1-6236)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ayah"
+                ],
+                "summary": "Get ayah by global ID",
+                "parameters": [
+                    {
+                        "maximum": 6236,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Global ayah ID (1-6236)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.AyahDetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ayah/{id}/card.svg": {
+            "get": {
+                "description": "Render an ayah as an SVG card with the Arabic text, translation and a Kemenag-style citation. The Arabic font is embedded, so the card needs no external resources.",
+                "produces": [
+                    "image/svg+xml"
+                ],
+                "tags": [
+                    "Ayah"
+                ],
+                "summary": "Ayah share card",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Global ayah ID (1-6236) or surah:ayah key",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "light",
+                            "dark",
+                            "sepia"
+                        ],
+                        "type": "string",
+                        "default": "light",
+                        "description": "Colour theme",
+                        "name": "theme",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "SVG image",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ayah/{id}/cite": {
+            "get": {
+                "description": "Format a citation for an ayah, or a range within its surah via ` + "`" + `to` + "`" + `. Styles: kemenag \"QS. Al-Baqarah [2]: 255\", short \"(Al-Baqarah 2:255)\", apa \"(The Qur'an, 2:255)\", chicago \"Qur'an, Al-Baqara 2:255.\" and turabian \"(Qur'an 2:255)\". The language selects surah name spelling.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ayah"
+                ],
+                "summary": "Cite an ayah",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Global ayah ID (1-6236) or surah:ayah key",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Last ayah number of a range in the same surah",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "kemenag",
+                            "short",
+                            "apa",
+                            "chicago",
+                            "turabian"
+                        ],
+                        "type": "string",
+                        "default": "kemenag",
+                        "description": "Citation style",
+                        "name": "style",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Language: id, en, a comma-separated list (id,en) or all",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.CitationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ayah/{id}/context": {
+            "get": {
+                "description": "Get an ayah with up to 10 ayahs before and after it in mushaf order. The window stops at the surah boundary unless cross_surah is true. The ayah carries prev and next references for navigation.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ayah"
+                ],
+                "summary": "Get an ayah with surrounding ayahs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Global ayah ID (1-6236) or surah:ayah key",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 2,
+                        "description": "Ayahs before",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10,
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 2,
+                        "description": "Ayahs after",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Continue into the previous and next surah",
+                        "name": "cross_surah",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.AyahContextResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/daily": {
+            "get": {
+                "description": "The same ayah for everyone on a given calendar day. The day is today in tz (Asia/Jakarta by default) unless date is given; theme draws from a curated list instead of the default pool.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ayah"
+                ],
+                "summary": "Ayah of the day",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Calendar day, YYYY-MM-DD (default today in tz)",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "Asia/Jakarta",
+                        "description": "IANA time zone deciding today",
+                        "name": "tz",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "doa",
+                            "ilmu",
+                            "rahmat",
+                            "sabar",
+                            "syukur"
+                        ],
+                        "type": "string",
+                        "description": "Curated theme",
+                        "name": "theme",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.DailyResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Check if the API is running",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/healthcheck.HealthCheck"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health/ready": {
+            "get": {
+                "description": "Check if the API is ready to serve requests (database connectivity)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/healthcheck.HealthCheck"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/juz": {
+            "get": {
+                "description": "Get a list of all 30 juz (parts) of the Quran",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Juz"
+                ],
+                "summary": "List all juz",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/juz.Juz"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/juz/{number}": {
+            "get": {
+                "description": "Get detailed information about a specific juz",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Juz"
+                ],
+                "summary": "Get juz by number",
+                "parameters": [
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Juz number (1-30)",
+                        "name": "number",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/juz.Juz"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/juz/{number}/ayah": {
+            "get": {
+                "description": "Get all ayahs from a specific juz with pagination",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson",
+                    "text/markdown",
+                    "text/plain"
+                ],
+                "tags": [
+                    "Juz"
+                ],
+                "summary": "Get ayahs by juz",
+                "parameters": [
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Juz number (1-30)",
+                        "name": "number",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ndjson",
+                            "markdown",
+                            "text"
+                        ],
+                        "type": "string",
+                        "description": "Output format (overrides Accept)",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.PageResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/handler.JuzAyahsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "RFC 8288 links to the first, prev, next and last pages"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/juz/{number}/stats": {
+            "get": {
+                "description": "Text statistics of a juz and of each of its ayahs, with letter-weighted progress through the mushaf",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Juz statistics",
+                "parameters": [
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Juz number (1-30)",
+                        "name": "number",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,progress",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/stats.Detail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/juz/{number}/surah": {
+            "get": {
+                "description": "Get all surahs that appear in a specific juz",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Ayah"
+                    "Juz"
                 ],
-                "summary": "Get ayah by global ID",
+                "summary": "Get surahs by juz",
                 "parameters": [
                     {
-                        "maximum": 6236,
+                        "maximum": 30,
                         "minimum": 1,
                         "type": "integer",
-                        "description": "Global ayah ID (1-6236)",
-                        "name": "id",
+                        "description": "Juz number (1-30)",
+                        "name": "number",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "enum": [
-                            "id",
-                            "en"
-                        ],
                         "type": "string",
-                        "default": "id",
-                        "description": "Translation language",
-                        "name": "lang",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
                         "in": "query"
                     }
                 ],
@@ -59,7 +1120,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.AyahDetailResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/juz.JuzSurah"
+                                            }
                                         }
                                     }
                                 }
@@ -87,37 +1151,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
+        "/oembed": {
             "get": {
-                "description": "Check if the API is running",
+                "description": "oEmbed 1.0 provider for /s/{surah}/{ayah} links. Returns a \"rich\" response whose html is a self-contained blockquote. Only the JSON format is supported.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Health"
+                    "Share"
+                ],
+                "summary": "oEmbed for ayah links",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shared ayah URL on this host, e.g. https://quran.api.digitalislami.id/s/2/255",
+                        "name": "url",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum width in pixels",
+                        "name": "maxwidth",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json"
+                        ],
+                        "type": "string",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Health check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/healthcheck.HealthCheck"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.OEmbedResponse"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "501": {
+                        "description": "Not Implemented",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -125,16 +1213,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/health/ready": {
+        "/plans/khatam": {
             "get": {
-                "description": "Check if the API is ready to serve requests (database connectivity)",
+                "description": "Splits the whole Quran into days portions that start and end on unit boundaries (juz, Madani page, or ayah - breaking between rukus where the day count allows) and are as even as possible in by: balanced (letters), words or ayahs. A preset fills in days, unit and start for Ramadan; explicit parameters win. Sent as iCalendar for Accept: text/calendar, or at /plans/khatam.ics.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/calendar"
                 ],
                 "tags": [
-                    "Health"
+                    "Plan"
+                ],
+                "summary": "Khatam reading plan",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Number of days (default 30, at most the number of units)",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day, YYYY-MM-DD (default today, or the first of the preset's Ramadan)",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "juz",
+                            "page",
+                            "ayah"
+                        ],
+                        "type": "string",
+                        "default": "ayah",
+                        "description": "Division portions start and end on",
+                        "name": "unit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "balanced",
+                            "words",
+                            "ayahs"
+                        ],
+                        "type": "string",
+                        "default": "balanced",
+                        "description": "What to keep even between days",
+                        "name": "by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ramadan",
+                            "ramadan-29",
+                            "ramadan-last10"
+                        ],
+                        "type": "string",
+                        "description": "Ramadan preset",
+                        "name": "preset",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Readiness check",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -147,13 +1286,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/healthcheck.HealthCheck"
+                                            "$ref": "#/definitions/handler.KhatamResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "503": {
                         "description": "Service Unavailable",
                         "schema": {
@@ -163,36 +1314,84 @@ const docTemplate = `{
                 }
             }
         },
-        "/juz": {
+        "/plans/khatam.ics": {
             "get": {
-                "description": "Get a list of all 30 juz (parts) of the Quran",
+                "description": "The plan from /plans/khatam as one all-day event per day, for calendar apps to import or subscribe to. Takes the same parameters.",
                 "produces": [
-                    "application/json"
+                    "text/calendar"
                 ],
                 "tags": [
-                    "Juz"
+                    "Plan"
+                ],
+                "summary": "Khatam reading plan as iCalendar",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Number of days (default 30, at most the number of units)",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day, YYYY-MM-DD (default today, or the first of the preset's Ramadan)",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "juz",
+                            "page",
+                            "ayah"
+                        ],
+                        "type": "string",
+                        "default": "ayah",
+                        "description": "Division portions start and end on",
+                        "name": "unit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "balanced",
+                            "words",
+                            "ayahs"
+                        ],
+                        "type": "string",
+                        "default": "balanced",
+                        "description": "What to keep even between days",
+                        "name": "by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ramadan",
+                            "ramadan-29",
+                            "ramadan-last10"
+                        ],
+                        "type": "string",
+                        "description": "Ramadan preset",
+                        "name": "preset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Language of the event titles: id or en",
+                        "name": "lang",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List all juz",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/juz.Juz"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
                     "500": {
@@ -200,29 +1399,60 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/juz/{number}": {
+        "/random": {
             "get": {
-                "description": "Get detailed information about a specific juz",
+                "description": "Get a random ayah, optionally filtered by surah. With seed the pick is reproducible: the same seed (and surah_id) always returns the same ayah.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Juz"
+                    "Ayah"
                 ],
-                "summary": "Get juz by number",
+                "summary": "Get random ayah",
                 "parameters": [
                     {
-                        "maximum": 30,
-                        "minimum": 1,
+                        "maximum": 114,
+                        "minimum": 0,
                         "type": "integer",
-                        "description": "Juz number (1-30)",
-                        "name": "number",
-                        "in": "path",
-                        "required": true
+                        "default": 0,
+                        "description": "Filter by surah ID (0 = any)",
+                        "name": "surah_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Seed for a reproducible pick",
+                        "name": "seed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -237,7 +1467,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/juz.Juz"
+                                            "$ref": "#/definitions/handler.AyahDetailResponse"
                                         }
                                     }
                                 }
@@ -265,24 +1495,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/juz/{number}/ayah": {
+        "/range": {
             "get": {
-                "description": "Get all ayahs from a specific juz with pagination",
+                "description": "Get every ayah between two references in mushaf order, crossing surah boundaries. A surah header item is inserted where each surah starts.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Juz"
+                    "Ayah"
                 ],
-                "summary": "Get ayahs by juz",
+                "summary": "Get ayahs across surahs",
                 "parameters": [
                     {
-                        "maximum": 30,
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "Juz number (1-30)",
-                        "name": "number",
-                        "in": "path",
+                        "type": "string",
+                        "example": "2:250",
+                        "description": "Start ayah as surah:ayah key or global ID",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "3:10",
+                        "description": "End ayah as surah:ayah key or global ID",
+                        "name": "to",
+                        "in": "query",
                         "required": true
                     },
                     {
@@ -297,21 +1534,29 @@ const docTemplate = `{
                         "maximum": 100,
                         "minimum": 1,
                         "type": "integer",
-                        "default": 50,
-                        "description": "Items per page",
+                        "default": 20,
+                        "description": "Ayahs per page",
                         "name": "limit",
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "id",
-                            "en"
-                        ],
                         "type": "string",
                         "default": "id",
-                        "description": "Translation language",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
                         "name": "lang",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -320,17 +1565,23 @@ const docTemplate = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/response.SuccessResponse"
+                                    "$ref": "#/definitions/response.PageResponse"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/handler.JuzAyahsResponse"
+                                            "$ref": "#/definitions/handler.AyahRangeResponse"
                                         }
                                     }
                                 }
                             ]
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "RFC 8288 links to the first, prev, next and last pages"
+                            }
                         }
                     },
                     "400": {
@@ -339,12 +1590,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -354,25 +1599,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/juz/{number}/surah": {
+        "/ref": {
             "get": {
-                "description": "Get all surahs that appear in a specific juz",
+                "description": "Parse a human-written verse reference and return its ayahs. Accepts numeric forms (\"2:255\", \"QS 2:1-5\"), comma lists (\"2:255, 257\"), cross-surah ranges (\"2:286-3:5\") and surah names in Indonesian or English spelling (\"Al-Baqarah 255\", \"yasin 1-12\").",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Juz"
+                    "Ayah"
                 ],
-                "summary": "Get surahs by juz",
+                "summary": "Resolve verse reference",
                 "parameters": [
                     {
-                        "maximum": 30,
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "Juz number (1-30)",
-                        "name": "number",
-                        "in": "path",
+                        "type": "string",
+                        "description": "Verse reference",
+                        "name": "q",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -387,10 +1649,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/juz.JuzSurah"
-                                            }
+                                            "$ref": "#/definitions/handler.ReferenceResponse"
                                         }
                                     }
                                 }
@@ -418,54 +1677,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/random": {
+        "/s/{surah}/{ayah}": {
             "get": {
-                "description": "Get a random ayah, optionally filtered by surah",
+                "description": "Server-rendered HTML page for an ayah with OpenGraph and Twitter summary meta tags, so shared links unfurl with the ayah text and translation. Advertises the oEmbed endpoint.",
                 "produces": [
-                    "application/json"
+                    "text/html"
                 ],
                 "tags": [
-                    "Ayah"
+                    "Share"
                 ],
-                "summary": "Get random ayah",
+                "summary": "Shareable ayah page",
                 "parameters": [
                     {
-                        "minimum": 0,
+                        "maximum": 114,
+                        "minimum": 1,
                         "type": "integer",
-                        "default": 0,
-                        "description": "Filter by surah ID (0 = any)",
-                        "name": "surah_id",
-                        "in": "query"
+                        "description": "Surah ID (1-114)",
+                        "name": "surah",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Ayah number in surah",
+                        "name": "ayah",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "enum": [
-                            "id",
-                            "en"
-                        ],
                         "type": "string",
                         "default": "id",
-                        "description": "Translation language",
+                        "description": "Translation language: id or en",
                         "name": "lang",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "HTML page",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.SuccessResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/handler.AyahDetailResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -493,7 +1744,11 @@ const docTemplate = `{
             "get": {
                 "description": "Get all 15 sajda tilawah ayahs in the Quran",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson",
+                    "text/markdown",
+                    "text/plain"
                 ],
                 "tags": [
                     "Ayah"
@@ -501,15 +1756,36 @@ const docTemplate = `{
                 "summary": "List sajda ayahs",
                 "parameters": [
                     {
-                        "enum": [
-                            "id",
-                            "en"
-                        ],
                         "type": "string",
                         "default": "id",
-                        "description": "Translation language",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
                         "name": "lang",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ndjson",
+                            "markdown",
+                            "text"
+                        ],
+                        "type": "string",
+                        "description": "Output format (overrides Accept)",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -553,7 +1829,11 @@ const docTemplate = `{
             "get": {
                 "description": "Full-text search across Quran ayahs (Arabic, Indonesian, English) using FTS5",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson",
+                    "text/markdown",
+                    "text/plain"
                 ],
                 "tags": [
                     "Search"
@@ -568,13 +1848,9 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "enum": [
-                            "id",
-                            "en"
-                        ],
                         "type": "string",
                         "default": "id",
-                        "description": "Translation language",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
                         "name": "lang",
                         "in": "query"
                     },
@@ -610,6 +1886,31 @@ const docTemplate = `{
                         "description": "Items per page",
                         "name": "limit",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ndjson",
+                            "markdown",
+                            "text"
+                        ],
+                        "type": "string",
+                        "description": "Output format (overrides Accept)",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -618,7 +1919,7 @@ const docTemplate = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/response.SuccessResponse"
+                                    "$ref": "#/definitions/response.PageResponse"
                                 },
                                 {
                                     "type": "object",
@@ -629,6 +1930,12 @@ const docTemplate = `{
                                     }
                                 }
                             ]
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "RFC 8288 links to the first, prev, next and last pages"
+                            }
                         }
                     },
                     "400": {
@@ -646,11 +1953,67 @@ const docTemplate = `{
                 }
             }
         },
+        "/stats": {
+            "get": {
+                "description": "Words, letters (without diacritics), characters and estimated recitation time of the whole mushaf, with every surah and juz. percent is a part's share of the mushaf's letters and progress the percentage read once it is finished.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Mushaf statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. number,percent",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/stats.Overview"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/surah": {
             "get": {
                 "description": "Get a list of all 114 surahs, optionally filtered by revelation type",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson",
+                    "text/markdown",
+                    "text/plain"
                 ],
                 "tags": [
                     "Surah"
@@ -663,8 +2026,102 @@ const docTemplate = `{
                             "medinan"
                         ],
                         "type": "string",
-                        "description": "Filter by revelation type",
-                        "name": "type",
+                        "description": "Filter by revelation type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ndjson",
+                            "markdown",
+                            "text"
+                        ],
+                        "type": "string",
+                        "description": "Output format (overrides Accept)",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/surah.Surah"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/surah/search": {
+            "get": {
+                "description": "Fuzzy search over surah names, best match first. Accepts Indonesian and international transliterations with their spelling variants (Yasin, Yaasiin, Ya Sin), slugs, Arabic names, English meanings (The Cow) and partial names (baq). score is 1 for an exact match and lower for looser ones; matched is the spelling that matched.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Surah"
+                ],
+                "summary": "Find surahs by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Surah name in any spelling",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Maximum results (1-114)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. number,slug,score",
+                        "name": "fields",
                         "in": "query"
                     }
                 ],
@@ -682,7 +2139,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/surah.Surah"
+                                                "$ref": "#/definitions/surah.Match"
                                             }
                                         }
                                     }
@@ -707,23 +2164,33 @@ const docTemplate = `{
         },
         "/surah/{id}": {
             "get": {
-                "description": "Get detailed information about a specific surah",
+                "description": "Get detailed information about a specific surah, by number or by name (a slug such as al-baqarah or any spelling /surah/search matches exactly)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Surah"
                 ],
-                "summary": "Get surah by ID",
+                "summary": "Get surah by ID or name",
                 "parameters": [
                     {
-                        "maximum": 114,
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "Surah ID (1-114)",
+                        "type": "string",
+                        "description": "Surah ID (1-114) or name, e.g. 36 or yasin",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words",
+                        "name": "include",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -770,7 +2237,11 @@ const docTemplate = `{
             "get": {
                 "description": "Get ayahs from a specific surah with optional range filtering",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson",
+                    "text/markdown",
+                    "text/plain"
                 ],
                 "tags": [
                     "Ayah"
@@ -778,10 +2249,8 @@ const docTemplate = `{
                 "summary": "Get ayahs by surah",
                 "parameters": [
                     {
-                        "maximum": 114,
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "Surah ID (1-114)",
+                        "type": "string",
+                        "description": "Surah ID (1-114) or name, e.g. 36 or yasin",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -799,15 +2268,36 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "id",
-                            "en"
-                        ],
                         "type": "string",
                         "default": "id",
-                        "description": "Translation language",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
                         "name": "lang",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ndjson",
+                            "markdown",
+                            "text"
+                        ],
+                        "type": "string",
+                        "description": "Output format (overrides Accept)",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -862,10 +2352,8 @@ const docTemplate = `{
                 "summary": "Get ayah by surah and number",
                 "parameters": [
                     {
-                        "maximum": 114,
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "Surah ID (1-114)",
+                        "type": "string",
+                        "description": "Surah ID (1-114) or name, e.g. 36 or yasin",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -879,15 +2367,23 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "enum": [
-                            "id",
-                            "en"
-                        ],
                         "type": "string",
                         "default": "id",
-                        "description": "Translation language",
+                        "description": "Translation language: id, en, a comma-separated list (id,en) or all",
                         "name": "lang",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,text_uthmani",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah, words, cite (see style)",
+                        "name": "include",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -929,9 +2425,121 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "/surah/{id}/stats": {
+            "get": {
+                "description": "Text statistics of a surah and of each of its ayahs, with letter-weighted progress through the mushaf",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Surah statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Surah ID (1-114) or name, e.g. 36 or yasin",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated fields to keep, e.g. id,progress",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated expansions: surah",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.SuccessResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/stats.Detail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
         }
     },
     "definitions": {
+        "handler.AyahBatchRequest": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "262",
+                        "2:255"
+                    ]
+                }
+            }
+        },
+        "handler.AyahContextResponse": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.AyahRangeAyah"
+                    }
+                },
+                "ayah": {
+                    "$ref": "#/definitions/handler.AyahDetailResponse"
+                },
+                "before": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.AyahRangeAyah"
+                    }
+                }
+            }
+        },
         "handler.AyahDetailResponse": {
             "type": "object",
             "properties": {
@@ -941,12 +2549,28 @@ const docTemplate = `{
                 "juz": {
                     "type": "integer"
                 },
+                "next": {
+                    "description": "null for the last ayah of the mushaf",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.AyahRef"
+                        }
+                    ]
+                },
                 "number": {
                     "type": "integer"
                 },
                 "number_in_surah": {
                     "type": "integer"
                 },
+                "prev": {
+                    "description": "null for the first ayah of the mushaf",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.AyahRef"
+                        }
+                    ]
+                },
                 "revelation_type": {
                     "type": "string"
                 },
@@ -964,40 +2588,195 @@ const docTemplate = `{
                 },
                 "translation": {
                     "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.AyahDetailSurahInfo": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name_latin": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.AyahListItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "juz": {
+                    "type": "integer"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "number_in_surah": {
+                    "type": "integer"
+                },
+                "sajda": {
+                    "type": "string"
+                },
+                "text_uthmani": {
+                    "type": "string"
+                },
+                "translation": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.AyahListResponse": {
+            "type": "object",
+            "properties": {
+                "ayahs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.AyahDetailResponse"
+                    }
+                }
+            }
+        },
+        "handler.AyahRangeAyah": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "juz": {
+                    "type": "integer"
+                },
+                "number_in_surah": {
+                    "type": "integer"
+                },
+                "sajda": {
+                    "type": "string"
+                },
+                "surah_id": {
+                    "type": "integer"
+                },
+                "text_uthmani": {
+                    "type": "string"
+                },
+                "translation": {
+                    "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.AyahRangeItem": {
+            "type": "object",
+            "properties": {
+                "ayah": {
+                    "$ref": "#/definitions/handler.AyahRangeAyah"
+                },
+                "surah": {
+                    "$ref": "#/definitions/handler.SurahSummaryResponse"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "surah",
+                        "ayah"
+                    ]
+                }
+            }
+        },
+        "handler.AyahRangeResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.AyahRangeItem"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.AyahDetailSurahInfo": {
+        "handler.AyahRef": {
             "type": "object",
             "properties": {
                 "id": {
-                    "type": "integer"
+                    "type": "integer",
+                    "example": 261
                 },
-                "name_latin": {
-                    "type": "string"
+                "key": {
+                    "type": "string",
+                    "example": "2:254"
                 }
             }
         },
-        "handler.AyahListItem": {
+        "handler.CitationResponse": {
             "type": "object",
             "properties": {
-                "juz": {
-                    "type": "integer"
-                },
-                "number": {
-                    "type": "integer"
+                "citation": {
+                    "type": "string"
                 },
-                "number_in_surah": {
-                    "type": "integer"
+                "citations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "sajda": {
+                "reference": {
                     "type": "string"
                 },
-                "text_uthmani": {
+                "style": {
                     "type": "string"
+                }
+            }
+        },
+        "handler.DailyResponse": {
+            "type": "object",
+            "properties": {
+                "ayah": {
+                    "$ref": "#/definitions/handler.AyahDetailResponse"
                 },
-                "translation": {
-                    "type": "string"
+                "date": {
+                    "type": "string",
+                    "example": "2026-10-19"
+                },
+                "theme": {
+                    "type": "string",
+                    "example": "sabar"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Jakarta"
                 }
             }
         },
@@ -1024,6 +2803,12 @@ const docTemplate = `{
                 },
                 "translation": {
                     "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -1052,6 +2837,158 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.KhatamDay": {
+            "type": "object",
+            "properties": {
+                "ayahs": {
+                    "type": "integer",
+                    "example": 148
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2027-02-08"
+                },
+                "day": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "from": {
+                    "type": "string",
+                    "example": "1:1"
+                },
+                "from_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "from_page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "letters": {
+                    "type": "integer",
+                    "example": 11273
+                },
+                "progress": {
+                    "type": "number",
+                    "example": 3.469
+                },
+                "recitation_seconds": {
+                    "type": "integer",
+                    "example": 2801
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2:141"
+                },
+                "to_id": {
+                    "type": "integer",
+                    "example": 148
+                },
+                "to_page": {
+                    "type": "integer",
+                    "example": 21
+                },
+                "words": {
+                    "type": "integer",
+                    "example": 2553
+                }
+            }
+        },
+        "handler.KhatamResponse": {
+            "type": "object",
+            "properties": {
+                "by": {
+                    "type": "string",
+                    "example": "balanced"
+                },
+                "days": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "end": {
+                    "type": "string",
+                    "example": "2027-03-09"
+                },
+                "preset": {
+                    "type": "string",
+                    "example": "ramadan"
+                },
+                "schedule": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.KhatamDay"
+                    }
+                },
+                "start": {
+                    "type": "string",
+                    "example": "2027-02-08"
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "juz"
+                }
+            }
+        },
+        "handler.OEmbedResponse": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "integer"
+                },
+                "html": {
+                    "type": "string"
+                },
+                "provider_name": {
+                    "type": "string"
+                },
+                "provider_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ReferenceResponse": {
+            "type": "object",
+            "properties": {
+                "query": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ReferenceResult"
+                    }
+                }
+            }
+        },
+        "handler.ReferenceResult": {
+            "type": "object",
+            "properties": {
+                "ayahs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.AyahListItem"
+                    }
+                },
+                "reference": {
+                    "type": "string"
+                },
+                "surah": {
+                    "$ref": "#/definitions/handler.SurahSummaryResponse"
+                }
+            }
+        },
         "handler.SajdaListItem": {
             "type": "object",
             "properties": {
@@ -1078,6 +3015,12 @@ const docTemplate = `{
                 },
                 "translation": {
                     "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -1195,16 +3138,59 @@ const docTemplate = `{
                 }
             }
         },
+        "pagination.Meta": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "next": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "prev": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
         "response.ErrorResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "string",
-                    "example": "not found"
+                    "example": "INVALID_SURAH_ID"
+                },
+                "details": {
+                    "type": "object"
                 },
                 "error": {
                     "type": "string",
-                    "example": "resource not found"
+                    "example": "id must be between 1 and 114"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "9f86d081884c7d65"
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                }
+            }
+        },
+        "response.PageResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
                 },
                 "timestamp": {
                     "type": "string",
@@ -1245,6 +3231,12 @@ const docTemplate = `{
                 },
                 "translation": {
                     "type": "string"
+                },
+                "translations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -1259,6 +3251,203 @@ const docTemplate = `{
                 }
             }
         },
+        "stats.AyahProgress": {
+            "type": "object",
+            "properties": {
+                "characters": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "letters": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "number_in_surah": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "progress": {
+                    "type": "number",
+                    "example": 0.044
+                },
+                "recitation_seconds": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "surah_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "words": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "stats.Detail": {
+            "type": "object",
+            "properties": {
+                "ayahs": {
+                    "type": "integer",
+                    "example": 286
+                },
+                "characters": {
+                    "type": "integer",
+                    "example": 51774
+                },
+                "first_ayah_id": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "last_ayah_id": {
+                    "type": "integer",
+                    "example": 293
+                },
+                "letters": {
+                    "type": "integer",
+                    "example": 25613
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "per_ayah": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/stats.AyahProgress"
+                    }
+                },
+                "percent": {
+                    "type": "number",
+                    "example": 7.887
+                },
+                "progress": {
+                    "type": "number",
+                    "example": 7.929
+                },
+                "recitation_seconds": {
+                    "type": "integer",
+                    "example": 6264
+                },
+                "words": {
+                    "type": "integer",
+                    "example": 6144
+                }
+            }
+        },
+        "stats.Overview": {
+            "type": "object",
+            "properties": {
+                "ayahs": {
+                    "type": "integer",
+                    "example": 286
+                },
+                "characters": {
+                    "type": "integer",
+                    "example": 51774
+                },
+                "juzs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/stats.Part"
+                    }
+                },
+                "letters": {
+                    "type": "integer",
+                    "example": 25613
+                },
+                "recitation_seconds": {
+                    "type": "integer",
+                    "example": 6264
+                },
+                "surahs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/stats.Part"
+                    }
+                },
+                "words": {
+                    "type": "integer",
+                    "example": 6144
+                }
+            }
+        },
+        "stats.Part": {
+            "type": "object",
+            "properties": {
+                "ayahs": {
+                    "type": "integer",
+                    "example": 286
+                },
+                "characters": {
+                    "type": "integer",
+                    "example": 51774
+                },
+                "letters": {
+                    "type": "integer",
+                    "example": 25613
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "percent": {
+                    "type": "number",
+                    "example": 7.887
+                },
+                "progress": {
+                    "type": "number",
+                    "example": 7.929
+                },
+                "recitation_seconds": {
+                    "type": "integer",
+                    "example": 6264
+                },
+                "words": {
+                    "type": "integer",
+                    "example": 6144
+                }
+            }
+        },
+        "surah.Match": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "matched": {
+                    "type": "string"
+                },
+                "name_arabic": {
+                    "type": "string"
+                },
+                "name_latin": {
+                    "type": "string"
+                },
+                "name_transliteration": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "number_of_ayahs": {
+                    "type": "integer"
+                },
+                "revelation_type": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
         "surah.Surah": {
             "type": "object",
             "properties": {
@@ -1282,6 +3471,9 @@ const docTemplate = `{
                 },
                 "revelation_type": {
                     "type": "string"
+                },
+                "slug": {
+                    "type": "string"
                 }
             }
         }
@@ -1292,10 +3484,10 @@ const docTemplate = `{
 var SwaggerInfo = &swag.Spec{
 	Version:          "1.0.0",
 	Host:             "localhost:8080",
-	BasePath:         "/",
+	BasePath:         "/v1",
 	Schemes:          []string{"http", "https"},
 	Title:            "Quran API Go",
-	Description:      "Internal RESTful API serving Al-Quran data (Arabic text, Indonesian & English translations) for the Ilmunara super app.\n\n---\n\n## MCP Server\n\nAPI ini dilengkapi dengan **MCP (Model Context Protocol) server** sehingga bisa digunakan langsung dari AI assistant seperti Claude, Cursor, dan tools lainnya.\n\n### Koneksi\n\n| | |\n|---|---|\n| **URL** | `https://quran.api.digitalislami.id/mcp` |\n| **Transport** | Streamable HTTP |\n| **Mode** | Stateless (tidak perlu session) |\n\n### Setup Claude Desktop\n\nTambahkan ke file `claude_desktop_config.json`:\n\n```json\n{\n\"mcpServers\": {\n\"quran\": {\n\"type\": \"http\",\n\"url\": \"https://quran.api.digitalislami.id/mcp\"\n}\n}\n}\n```\n\n### Tools yang Tersedia\n\n| Tool | Deskripsi |\n|---|---|\n| `list_surahs` | Daftar semua 114 surah |\n| `get_surah` | Detail surah berdasarkan ID |\n| `get_ayahs_by_surah` | Ayat-ayat dalam surah tertentu |\n| `get_ayah` | Ayat berdasarkan ID global |\n| `get_ayah_by_ref` | Ayat berdasarkan nomor surah dan ayat |\n| `random_ayah` | Ayat acak |\n| `list_juz` | Daftar semua 30 juz |\n| `get_juz` | Detail juz tertentu |\n| `get_ayahs_by_juz` | Ayat-ayat dalam juz tertentu |\n| `search_quran` | Pencarian full-text (Arab, Indonesia, Inggris) |\n\n### Contoh Penggunaan\n\nSetelah terhubung, kamu bisa langsung tanya ke AI:\n\n> *\"Tampilkan ayat pertama surah Al-Baqarah beserta terjemahannya\"*\n\n> *\"Cari ayat yang mengandung kata 'sabar' dalam terjemahan Indonesia\"*\n\n> *\"Surah apa saja yang ada di Juz 30?\"*",
+	Description:      "Internal RESTful API serving Al-Quran data (Arabic text, Indonesian & English translations) for the Ilmunara super app.\n\n---\n\n## MCP Server\n\nAPI ini dilengkapi dengan **MCP (Model Context Protocol) server** sehingga bisa digunakan langsung dari AI assistant seperti Claude, Cursor, dan tools lainnya.\n\n### Koneksi\n\n| | |\n|---|---|\n| **URL** | `https://quran.api.digitalislami.id/mcp` |\n| **Transport** | Streamable HTTP |\n| **Mode** | Stateless (tidak perlu session) |\n\n### Setup Claude Desktop\n\nTambahkan ke file `claude_desktop_config.json`:\n\n```json\n{\n\"mcpServers\": {\n\"quran\": {\n\"type\": \"http\",\n\"url\": \"https://quran.api.digitalislami.id/mcp\"\n}\n}\n}\n```\n\n### Tools yang Tersedia\n\n| Tool | Deskripsi |\n|---|---|\n| `list_surahs` | Daftar semua 114 surah |\n| `get_surah` | Detail surah berdasarkan ID |\n| `get_ayahs_by_surah` | Ayat-ayat dalam surah tertentu |\n| `get_ayah` | Ayat berdasarkan ID global |\n| `get_ayah_by_ref` | Ayat berdasarkan nomor surah dan ayat |\n| `get_ayahs_by_reference` | Ayat berdasarkan referensi bebas (`2:255`, `QS 2:1-5`, `yasin 1-12`) |\n| `random_ayah` | Ayat acak |\n| `list_juz` | Daftar semua 30 juz |\n| `get_juz` | Detail juz tertentu |\n| `get_ayahs_by_juz` | Ayat-ayat dalam juz tertentu |\n| `search_quran` | Pencarian full-text (Arab, Indonesia, Inggris) |\n\n### Contoh Penggunaan\n\nSetelah terhubung, kamu bisa langsung tanya ke AI:\n\n> *\"Tampilkan ayat pertama surah Al-Baqarah beserta terjemahannya\"*\n\n> *\"Cari ayat yang mengandung kata 'sabar' dalam terjemahan Indonesia\"*\n\n> *\"Surah apa saja yang ada di Juz 30?\"*",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
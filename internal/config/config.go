@@ -1,14 +1,35 @@
 package config
 
-import "os"
+import (
+	"os"
+	"strconv"
+)
 
 type Config struct {
 	DBPath         string
 	ServerHost     string
 	ServerPort     string
+	GRPCPort       string // port for cmd/grpc; it shares SERVER_HOST
 	AllowedOrigins string
 	AppVersion     string
 	LogLevel       string
+	// DatasetVersion is mixed into ETags; defaults to the database file's
+	// modification time when empty.
+	DatasetVersion   string
+	CacheControl     string // Cache-Control for Quran data routes
+	DocsCacheControl string // Cache-Control for docs and static files
+	// Dates (YYYY-MM-DD) announced on the unversioned API aliases. Without a
+	// deprecation date the aliases carry no deprecation headers.
+	UnversionedDeprecation string
+	UnversionedSunset      string
+	// GraphQL query limits; 0 disables a check. The defaults allow any single
+	// screen (a surah with its ayahs, a juz page) but not the whole mushaf.
+	GraphQLMaxDepth      int
+	GraphQLMaxComplexity int
+	// DailyTimezone decides when /daily moves to the next ayah; DailyAyahs
+	// optionally narrows it to a curated list of references ("2:255, 36:58").
+	DailyTimezone string
+	DailyAyahs    string
 }
 
 func Load() Config {
@@ -16,9 +37,23 @@ func Load() Config {
 		DBPath:         getenv("DB_PATH", "./data/quran.db"),
 		ServerHost:     getenv("SERVER_HOST", "0.0.0.0"),
 		ServerPort:     getenv("SERVER_PORT", "8080"),
+		GRPCPort:       getenv("GRPC_PORT", "9090"),
 		AllowedOrigins: getenv("ALLOWED_ORIGINS", ""),
 		AppVersion:     getenv("APP_VERSION", "1.0.0"),
 		LogLevel:       getenv("LOG_LEVEL", "info"),
+
+		DatasetVersion:   getenv("DATASET_VERSION", ""),
+		CacheControl:     getenv("CACHE_CONTROL", "public, max-age=86400, stale-while-revalidate=604800"),
+		DocsCacheControl: getenv("DOCS_CACHE_CONTROL", "public, max-age=3600"),
+
+		UnversionedDeprecation: getenv("UNVERSIONED_DEPRECATION", ""),
+		UnversionedSunset:      getenv("UNVERSIONED_SUNSET", ""),
+
+		GraphQLMaxDepth:      getenvInt("GRAPHQL_MAX_DEPTH", 8),
+		GraphQLMaxComplexity: getenvInt("GRAPHQL_MAX_COMPLEXITY", 5000),
+
+		DailyTimezone: getenv("DAILY_TIMEZONE", "Asia/Jakarta"),
+		DailyAyahs:    getenv("DAILY_AYAHS", ""),
 	}
 
 	return cfg
@@ -30,3 +65,10 @@ func getenv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func getenvInt(key string, fallback int) int {
+	if n, err := strconv.Atoi(os.Getenv(key)); err == nil && n >= 0 {
+		return n
+	}
+	return fallback
+}
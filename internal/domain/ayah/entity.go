@@ -24,3 +24,29 @@ type SajdaAyah struct {
 	JuzNumber      int    `json:"juz_number"`
 	SajdaType      string `json:"sajda_type"`
 }
+
+// Filter selects ayahs for the /ayah query endpoint. Every condition is
+// optional and they combine with AND; zero values mean no filter.
+type Filter struct {
+	SurahID    int
+	Juz        int
+	Revelation string // "meccan" or "medinan"
+	Sajda      *bool  // true: only sajda ayahs, false: none of them
+	MinWords   int
+	Sort       Sort
+	Limit      int
+	Offset     int
+}
+
+// Sort orders filtered ayahs by a field, ascending, or descending when
+// prefixed with "-". Ties keep mushaf order.
+type Sort string
+
+const (
+	SortMushaf      Sort = "id"
+	SortMushafDesc  Sort = "-id"
+	SortWords       Sort = "words"
+	SortWordsDesc   Sort = "-words"
+	SortLetters     Sort = "letters"
+	SortLettersDesc Sort = "-letters"
+)
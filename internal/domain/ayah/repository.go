@@ -6,8 +6,11 @@ import "context"
 // Implement this interface in internal/repository/ayah_repository.go.
 type AyahRepository interface {
 	FindByID(ctx context.Context, id int) (*Ayah, error)
+	FindByIDs(ctx context.Context, ids []int) ([]Ayah, error)
 	FindBySurah(ctx context.Context, surahID, from, to int) ([]Ayah, error)
+	FindByIDRange(ctx context.Context, fromID, toID, limit, offset int) ([]Ayah, error)
 	FindBySurahAndNumber(ctx context.Context, surahID, number int) (*Ayah, error)
 	FindRandom(ctx context.Context, surahID int) (*Ayah, error) // surahID=0 means any surah
 	FindSajda(ctx context.Context) ([]SajdaAyah, error)
+	FindByFilter(ctx context.Context, f Filter) (ayahs []Ayah, total int, err error)
 }
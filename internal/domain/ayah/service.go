@@ -6,8 +6,11 @@ import "context"
 // Implement this interface in internal/service/ayah_service.go.
 type AyahService interface {
 	GetByID(ctx context.Context, id int) (*Ayah, error)
+	GetByIDs(ctx context.Context, ids []int) ([]Ayah, error) // result follows the order of ids
 	GetBySurah(ctx context.Context, surahID, from, to int) ([]Ayah, error)
+	GetByIDRange(ctx context.Context, fromID, toID, limit, offset int) ([]Ayah, error) // mushaf order, may cross surahs
 	GetBySurahAndNumber(ctx context.Context, surahID, number int) (*Ayah, error)
 	GetRandom(ctx context.Context, surahID int) (*Ayah, error)
 	GetSajda(ctx context.Context) ([]SajdaAyah, error)
+	Query(ctx context.Context, f Filter) (ayahs []Ayah, total int, err error)
 }
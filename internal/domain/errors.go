@@ -3,8 +3,48 @@ package domain
 import "errors"
 
 var (
-	ErrNotFound          = errors.New("resource not found")
-	ErrInvalidLang       = errors.New("invalid language parameter")
-	ErrInvalidIDParam    = errors.New("invalid id parameter")
-	ErrInvalidRangeParam = errors.New("invalid range parameter")
+	ErrNotFound             = errors.New("resource not found")
+	ErrInvalidLang          = errors.New("invalid language parameter")
+	ErrInvalidIDParam       = errors.New("invalid id parameter")
+	ErrInvalidRangeParam    = errors.New("invalid range parameter")
+	ErrInvalidReference     = errors.New("invalid verse reference")
+	ErrInvalidCitationStyle = errors.New("invalid citation style")
+	ErrDatasetIncomplete    = errors.New("dataset is missing required data")
+)
+
+// Code is a stable, machine-readable error code, sent as "code" in every
+// error response. Clients match on it; the human-readable message may be
+// reworded or localised at any time.
+type Code string
+
+const (
+	CodeInternal          Code = "INTERNAL_ERROR"
+	CodeNotImplemented    Code = "NOT_IMPLEMENTED"
+	CodeRouteNotFound     Code = "ROUTE_NOT_FOUND"
+	CodeDatasetIncomplete Code = "DATASET_INCOMPLETE"
+
+	CodeSurahNotFound Code = "SURAH_NOT_FOUND"
+	CodeAyahNotFound  Code = "AYAH_NOT_FOUND"
+	CodeJuzNotFound   Code = "JUZ_NOT_FOUND"
+	CodeShareNotFound Code = "SHARE_LINK_NOT_FOUND"
+
+	CodeInvalidSurahID        Code = "INVALID_SURAH_ID"
+	CodeInvalidAyahID         Code = "INVALID_AYAH_ID"
+	CodeInvalidAyahNumber     Code = "INVALID_AYAH_NUMBER"
+	CodeInvalidAyahRange      Code = "INVALID_AYAH_RANGE"
+	CodeInvalidJuzNumber      Code = "INVALID_JUZ_NUMBER"
+	CodeInvalidLang           Code = "INVALID_LANG"
+	CodeInvalidSearchQuery    Code = "INVALID_SEARCH_QUERY"
+	CodeInvalidReference      Code = "INVALID_REFERENCE"
+	CodeReferenceTooLarge     Code = "REFERENCE_TOO_LARGE"
+	CodeInvalidCitationStyle  Code = "INVALID_CITATION_STYLE"
+	CodeInvalidRevelationType Code = "INVALID_REVELATION_TYPE"
+	CodeInvalidTheme          Code = "INVALID_THEME"
+	CodeInvalidFormat         Code = "INVALID_FORMAT"
+	CodeInvalidFields         Code = "INVALID_FIELDS"
+	CodeInvalidInclude        Code = "INVALID_INCLUDE"
+	CodeInvalidPagination     Code = "INVALID_PAGINATION"
+	CodeInvalidRequestBody    Code = "INVALID_REQUEST_BODY"
+	CodeTooManyIDs            Code = "TOO_MANY_IDS"
+	CodeInvalidParam          Code = "INVALID_PARAM"
 )
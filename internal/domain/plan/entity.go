@@ -0,0 +1,63 @@
+package plan
+
+// Unit is the division every daily portion of a plan starts and ends on.
+type Unit string
+
+const (
+	UnitJuz  Unit = "juz"
+	UnitPage Unit = "page" // Madani mushaf pages
+	UnitAyah Unit = "ayah" // ends on a ruku where the day count allows
+)
+
+// Size is how many units make up the Quran, and so the most days a plan
+// in that unit can span.
+func (u Unit) Size() int {
+	switch u {
+	case UnitJuz:
+		return 30
+	case UnitPage:
+		return 604
+	case UnitAyah:
+		return 6236
+	}
+	return 0
+}
+
+// Measure is what a plan keeps even from one day to the next.
+type Measure string
+
+const (
+	MeasureBalanced Measure = "balanced" // letters, the closest to reading time
+	MeasureWords    Measure = "words"
+	MeasureAyahs    Measure = "ayahs"
+)
+
+// AyahWeight places an ayah in the mushaf's divisions and gives its length.
+// Page, ruku and lengths are 0 in datasets seeded before they were added.
+type AyahWeight struct {
+	ID            int
+	SurahID       int
+	NumberInSurah int
+	JuzNumber     int
+	PageNumber    int
+	RukuNumber    int
+	Words         int
+	Letters       int
+	RecitationMS  int
+}
+
+// Portion is one day of a plan: the ayahs FromID to ToID in mushaf order.
+type Portion struct {
+	FromID       int
+	ToID         int
+	FromSurah    int
+	FromAyah     int
+	ToSurah      int
+	ToAyah       int
+	FromPage     int
+	ToPage       int
+	Ayahs        int
+	Words        int
+	Letters      int
+	RecitationMS int
+}
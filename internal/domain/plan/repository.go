@@ -0,0 +1,10 @@
+package plan
+
+import "context"
+
+// PlanRepository defines read-only access to the data reading plans are
+// built from.
+// Implement this interface in internal/repository/plan_repository.go.
+type PlanRepository interface {
+	FindAyahWeights(ctx context.Context) ([]AyahWeight, error) // mushaf order
+}
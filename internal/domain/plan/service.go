@@ -0,0 +1,12 @@
+package plan
+
+import "context"
+
+// PlanService defines the business operations for reading plans.
+// Implement this interface in internal/service/plan_service.go.
+type PlanService interface {
+	// Khatam cuts the whole Quran into days portions on unit boundaries,
+	// as even in measure as those boundaries allow. days must be between 1
+	// and unit.Size().
+	Khatam(ctx context.Context, days int, unit Unit, measure Measure) ([]Portion, error)
+}
@@ -4,21 +4,23 @@ package search
 type Params struct {
 	Query   string
 	Lang    string
-	SurahID int // 0 = no filter
-	Juz     int // 0 = no filter
+	Langs   []string // two or more languages fill Result.Translations instead
+	SurahID int      // 0 = no filter
+	Juz     int      // 0 = no filter
 	Page    int
 	Limit   int
 }
 
 // Result is a single ayah match returned from a search query.
 type Result struct {
-	ID            int       `json:"id"`
-	SurahID       int       `json:"surah_id"`
-	SurahInfo     SurahInfo `json:"surah_info"`
-	NumberInSurah int       `json:"number_in_surah"`
-	TextUthmani   string    `json:"text_uthmani"`
-	Translation   string    `json:"translation"`
-	JuzNumber     int       `json:"juz_number"`
+	ID            int               `json:"id"`
+	SurahID       int               `json:"surah_id"`
+	SurahInfo     SurahInfo         `json:"surah_info"`
+	NumberInSurah int               `json:"number_in_surah"`
+	TextUthmani   string            `json:"text_uthmani"`
+	Translation   string            `json:"translation,omitempty"`
+	Translations  map[string]string `json:"translations,omitempty"`
+	JuzNumber     int               `json:"juz_number"`
 }
 
 // SurahInfo is the minimal surah metadata embedded in a search result.
@@ -0,0 +1,86 @@
+package stats
+
+// Scope is the kind of stretch of the mushaf a Stats row measures.
+type Scope string
+
+const (
+	ScopeMushaf Scope = "mushaf"
+	ScopeSurah  Scope = "surah"
+	ScopeJuz    Scope = "juz"
+)
+
+// Stats are the seeded text statistics of the mushaf, a surah or a juz.
+// LettersBefore is how many of the mushaf's letters come before it.
+type Stats struct {
+	Scope         Scope
+	Number        int
+	FirstAyahID   int
+	LastAyahID    int
+	Ayahs         int
+	Words         int
+	Letters       int
+	Characters    int
+	RecitationMS  int
+	LettersBefore int
+}
+
+// AyahStats are the seeded text statistics of one ayah.
+type AyahStats struct {
+	ID            int
+	SurahID       int
+	NumberInSurah int
+	Words         int
+	Letters       int
+	Characters    int
+	RecitationMS  int
+}
+
+// Counts measure a stretch of text. Letters leave out diacritics and pause
+// marks; characters count every non-space code point of text_uthmani.
+// Recitation is an estimate at a measured (murattal) pace.
+type Counts struct {
+	Ayahs             int `json:"ayahs" example:"286"`
+	Words             int `json:"words" example:"6144"`
+	Letters           int `json:"letters" example:"25613"`
+	Characters        int `json:"characters" example:"51774"`
+	RecitationSeconds int `json:"recitation_seconds" example:"6264"`
+}
+
+// Part is a surah or juz within the mushaf. Percent is its share of the
+// mushaf's letters and Progress the percentage of the mushaf read once it
+// is finished, so progress follows the length of the text, not the number
+// of ayahs.
+type Part struct {
+	Number int `json:"number" example:"2"`
+	Counts
+	Percent  float64 `json:"percent" example:"7.887"`
+	Progress float64 `json:"progress" example:"7.929"`
+}
+
+// Overview is the whole mushaf with every surah and juz.
+type Overview struct {
+	Counts
+	Surahs []Part `json:"surahs"`
+	Juzs   []Part `json:"juzs"`
+}
+
+// Detail is a surah or juz with the statistics of each of its ayahs.
+type Detail struct {
+	Part
+	FirstAyahID int            `json:"first_ayah_id" example:"8"`
+	LastAyahID  int            `json:"last_ayah_id" example:"293"`
+	PerAyah     []AyahProgress `json:"per_ayah"`
+}
+
+// AyahProgress is one ayah's statistics and the percentage of the mushaf
+// read through it.
+type AyahProgress struct {
+	ID                int     `json:"id" example:"8"`
+	SurahID           int     `json:"surah_id" example:"2"`
+	NumberInSurah     int     `json:"number_in_surah" example:"1"`
+	Words             int     `json:"words" example:"1"`
+	Letters           int     `json:"letters" example:"3"`
+	Characters        int     `json:"characters" example:"6"`
+	RecitationSeconds int     `json:"recitation_seconds" example:"3"`
+	Progress          float64 `json:"progress" example:"0.044"`
+}
@@ -0,0 +1,11 @@
+package stats
+
+import "context"
+
+// StatsRepository defines read-only access to the seeded text statistics.
+// Implement this interface in internal/repository/stats_repository.go.
+type StatsRepository interface {
+	FindByScope(ctx context.Context, scope Scope) ([]Stats, error) // ordered by number
+	FindOne(ctx context.Context, scope Scope, number int) (*Stats, error)
+	FindAyahs(ctx context.Context, fromID, toID int) ([]AyahStats, error)
+}
@@ -0,0 +1,11 @@
+package stats
+
+import "context"
+
+// StatsService defines the business operations for text statistics.
+// Implement this interface in internal/service/stats_service.go.
+type StatsService interface {
+	GetOverview(ctx context.Context) (*Overview, error)
+	GetSurah(ctx context.Context, id int) (*Detail, error)
+	GetJuz(ctx context.Context, number int) (*Detail, error)
+}
@@ -3,9 +3,18 @@ package surah
 type Surah struct {
 	ID                  int    `json:"id"`
 	Number              int    `json:"number"`
+	Slug                string `json:"slug"`
 	NameArabic          string `json:"name_arabic"`
 	NameLatin           string `json:"name_latin"`
 	NameTransliteration string `json:"name_transliteration"`
 	NumberOfAyahs       int    `json:"number_of_ayahs"`
 	RevelationType      string `json:"revelation_type"`
 }
+
+// Match is a surah found by name, with the spelling that matched and how
+// closely (1 for an exact match).
+type Match struct {
+	Surah
+	Matched string  `json:"matched"`
+	Score   float64 `json:"score"`
+}
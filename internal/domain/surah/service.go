@@ -8,4 +8,5 @@ type SurahService interface {
 	GetAll(ctx context.Context) ([]Surah, error)
 	GetByID(ctx context.Context, id int) (*Surah, error)
 	GetByRevelationType(ctx context.Context, revelationType string) ([]Surah, error)
+	Search(ctx context.Context, q string, limit int) ([]Match, error)
 }
@@ -0,0 +1,137 @@
+package graphqlserver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Limits bounds the cost of a single query. Both are checked on the parsed
+// document before anything is resolved.
+type Limits struct {
+	// MaxDepth is the deepest allowed field nesting; { surah { ayahs { id } } }
+	// has depth 3.
+	MaxDepth int
+	// MaxComplexity caps the estimated number of resolved fields: each field
+	// costs 1, multiplied by the expected size of every enclosing list.
+	MaxComplexity int
+}
+
+// listSizes is the expected length of each list field when its arguments do
+// not say. Surah.ayahs uses the average surah length.
+var listSizes = map[string]int{
+	"surahs": 114,
+	"juzs":   30,
+	"ayahs":  55,
+}
+
+// checkLimits reports the first limit the operation exceeds. Introspection
+// fields (__schema, __type) are not counted.
+func checkLimits(doc *ast.Document, operationName string, variables map[string]any, limits Limits) error {
+	fragments := map[string]*ast.FragmentDefinition{}
+	var operation *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.FragmentDefinition:
+			fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operationName == "" || (def.Name != nil && def.Name.Value == operationName) {
+				operation = def
+			}
+		}
+	}
+	if operation == nil {
+		return nil // graphql-go reports the missing operation
+	}
+
+	c := costCounter{fragments: fragments, variables: variables, visiting: map[string]bool{}}
+	depth, complexity := c.selectionSet(operation.SelectionSet)
+	if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+		return fmt.Errorf("query depth %d exceeds the limit of %d", depth, limits.MaxDepth)
+	}
+	if limits.MaxComplexity > 0 && complexity > limits.MaxComplexity {
+		return fmt.Errorf("query complexity %d exceeds the limit of %d", complexity, limits.MaxComplexity)
+	}
+	return nil
+}
+
+type costCounter struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+	visiting  map[string]bool // guards against fragment cycles before validation
+}
+
+// selectionSet returns the depth and complexity of set.
+func (c costCounter) selectionSet(set *ast.SelectionSet) (depth, complexity int) {
+	if set == nil {
+		return 0, 0
+	}
+	for _, selection := range set.Selections {
+		var d, cost int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name.Value, "__") {
+				continue
+			}
+			childDepth, childCost := c.selectionSet(s.SelectionSet)
+			d, cost = childDepth+1, 1+c.listSize(s)*childCost
+		case *ast.InlineFragment:
+			d, cost = c.selectionSet(s.SelectionSet)
+		case *ast.FragmentSpread:
+			fragment, ok := c.fragments[s.Name.Value]
+			if !ok || c.visiting[s.Name.Value] {
+				continue
+			}
+			c.visiting[s.Name.Value] = true
+			d, cost = c.selectionSet(fragment.SelectionSet)
+			delete(c.visiting, s.Name.Value)
+		}
+		depth = max(depth, d)
+		complexity += cost
+	}
+	return depth, complexity
+}
+
+// listSize estimates how many items field resolves to: the limit argument,
+// the span of from/to arguments, the listSizes default, or 1 for objects.
+func (c costCounter) listSize(field *ast.Field) int {
+	args := map[string]int{}
+	for _, arg := range field.Arguments {
+		if n, ok := c.intValue(arg.Value); ok {
+			args[arg.Name.Value] = n
+		}
+	}
+	if limit, ok := args["limit"]; ok {
+		return max(limit, 1)
+	}
+	from, hasFrom := args["from"]
+	to, hasTo := args["to"]
+	if hasFrom && hasTo && to >= from {
+		return to - from + 1
+	}
+	if size, ok := listSizes[field.Name.Value]; ok {
+		return size
+	}
+	if field.Name.Value == "search" {
+		return defaultSearchLimit
+	}
+	return 1
+}
+
+func (c costCounter) intValue(value ast.Value) (int, bool) {
+	switch v := value.(type) {
+	case *ast.IntValue:
+		n, err := strconv.Atoi(v.Value)
+		return n, err == nil
+	case *ast.Variable:
+		switch n := c.variables[v.Name.Value].(type) {
+		case int:
+			return n, true
+		case float64:
+			return int(n), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,195 @@
+package graphqlserver
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/domain/juz"
+	"quran-api-go/internal/domain/surah"
+)
+
+// thunk is a deferred resolver result. graphql-go completes every field of
+// one tree level before calling that level's thunks, so keys requested by
+// sibling resolvers accumulate in a loader and are fetched in one batch when
+// the first thunk runs.
+type thunk = func() (any, error)
+
+// rangePageSize is the largest limit ayah.AyahService.GetByIDRange honours.
+const rangePageSize = 100
+
+// ayahSpan is an inclusive range of global ayah IDs.
+type ayahSpan struct{ from, to int }
+
+// loaders holds the per-request caches. Surahs and juz are small enough to
+// load whole on first use; ayahs are batched by span.
+type loaders struct {
+	surahSvc surah.SurahService
+	ayahSvc  ayah.AyahService
+	juzSvc   juz.JuzService
+
+	surahsOnce sync.Once
+	surahs     []surah.Surah
+	surahByID  map[int]*surah.Surah
+	surahsErr  error
+
+	juzsOnce sync.Once
+	juzs     []juz.Juz
+	juzsErr  error
+
+	mu      sync.Mutex
+	pending map[ayahSpan]struct{}
+	ayahs   map[int]ayah.Ayah // by global ID, filled by each batch
+	loaded  map[ayahSpan]bool
+	err     error
+}
+
+func newLoaders(surahSvc surah.SurahService, ayahSvc ayah.AyahService, juzSvc juz.JuzService) *loaders {
+	return &loaders{
+		surahSvc: surahSvc,
+		ayahSvc:  ayahSvc,
+		juzSvc:   juzSvc,
+		pending:  map[ayahSpan]struct{}{},
+		ayahs:    map[int]ayah.Ayah{},
+		loaded:   map[ayahSpan]bool{},
+	}
+}
+
+func (l *loaders) allSurahs(ctx context.Context) ([]surah.Surah, error) {
+	l.surahsOnce.Do(func() {
+		l.surahs, l.surahsErr = l.surahSvc.GetAll(ctx)
+		l.surahByID = make(map[int]*surah.Surah, len(l.surahs))
+		for i := range l.surahs {
+			l.surahByID[l.surahs[i].ID] = &l.surahs[i]
+		}
+	})
+	return l.surahs, l.surahsErr
+}
+
+func (l *loaders) surah(ctx context.Context, id int) (*surah.Surah, error) {
+	if _, err := l.allSurahs(ctx); err != nil {
+		return nil, err
+	}
+	return l.surahByID[id], nil
+}
+
+func (l *loaders) allJuzs(ctx context.Context) ([]juz.Juz, error) {
+	l.juzsOnce.Do(func() {
+		l.juzs, l.juzsErr = l.juzSvc.GetAll(ctx)
+	})
+	return l.juzs, l.juzsErr
+}
+
+func (l *loaders) juz(ctx context.Context, number int) (*juz.Juz, error) {
+	all, err := l.allJuzs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range all {
+		if all[i].JuzNumber == number {
+			return &all[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// ayahSpan queues span and returns a thunk yielding its ayahs in mushaf
+// order.
+func (l *loaders) ayahSpan(ctx context.Context, span ayahSpan) thunk {
+	l.mu.Lock()
+	if !l.loaded[span] {
+		l.pending[span] = struct{}{}
+	}
+	l.mu.Unlock()
+
+	return func() (any, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		// A thunk whose span came in an earlier batch leaves the keys queued
+		// by its siblings for the next level's first thunk.
+		if !l.loaded[span] {
+			if err := l.flush(ctx); err != nil {
+				return nil, err
+			}
+		}
+		if l.err != nil {
+			return nil, l.err
+		}
+		result := make([]ayah.Ayah, 0, span.to-span.from+1)
+		for id := span.from; id <= span.to; id++ {
+			if item, ok := l.ayahs[id]; ok {
+				result = append(result, item)
+			}
+		}
+		return result, nil
+	}
+}
+
+// ayah queues a single ayah and returns a thunk yielding *ayah.Ayah, or nil
+// when it does not exist.
+func (l *loaders) ayah(ctx context.Context, id int) thunk {
+	span := l.ayahSpan(ctx, ayahSpan{id, id})
+	return func() (any, error) {
+		result, err := span()
+		if err != nil {
+			return nil, err
+		}
+		if items := result.([]ayah.Ayah); len(items) == 1 {
+			return &items[0], nil
+		}
+		return nil, nil
+	}
+}
+
+// flush fetches every pending span. Overlapping and adjacent spans are merged
+// into runs read with GetByIDRange; isolated single ayahs share one GetByIDs
+// call. The caller holds l.mu.
+func (l *loaders) flush(ctx context.Context) error {
+	spans := make([]ayahSpan, 0, len(l.pending))
+	for span := range l.pending {
+		spans = append(spans, span)
+		l.loaded[span] = true
+	}
+	l.pending = map[ayahSpan]struct{}{}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].from < spans[j].from })
+
+	var runs []ayahSpan
+	for _, span := range spans {
+		if n := len(runs); n > 0 && span.from <= runs[n-1].to+1 {
+			runs[n-1].to = max(runs[n-1].to, span.to)
+			continue
+		}
+		runs = append(runs, span)
+	}
+
+	var singles []int
+	for _, run := range runs {
+		if run.from == run.to {
+			singles = append(singles, run.from)
+			continue
+		}
+		// GetByIDRange pages at most rangePageSize ayahs per call.
+		for offset := 0; offset <= run.to-run.from; offset += rangePageSize {
+			items, err := l.ayahSvc.GetByIDRange(ctx, run.from, run.to, rangePageSize, offset)
+			if err != nil {
+				l.err = err
+				return err
+			}
+			for _, item := range items {
+				l.ayahs[item.ID] = item
+			}
+		}
+	}
+	if len(singles) > 0 {
+		items, err := l.ayahSvc.GetByIDs(ctx, singles)
+		if err != nil {
+			l.err = err
+			return err
+		}
+		for _, item := range items {
+			l.ayahs[item.ID] = item
+		}
+	}
+	return nil
+}
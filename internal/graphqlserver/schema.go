@@ -0,0 +1,486 @@
+package graphqlserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/domain/juz"
+	"quran-api-go/internal/domain/search"
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/pkg/reference"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	defaultJuzAyahs    = 20
+)
+
+type loadersKey struct{}
+
+// loadersFrom returns the request's loaders, installed by Server.ServeHTTP.
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// searchPage is the value behind the SearchPage type.
+type searchPage struct {
+	Total   int
+	Page    int
+	Limit   int
+	Results []search.Result
+}
+
+// newSchema builds the schema. Object fields reference each other, so the
+// relation fields are added after every type exists.
+func newSchema(searchSvc search.SearchService) (graphql.Schema, error) {
+	surahType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Surah",
+		Description: "One of the 114 surahs.",
+		Fields: graphql.Fields{
+			"id":                  intField("Surah ID (1-114)", func(s *surah.Surah) int { return s.ID }, asSurah),
+			"number":              intField("Surah number", func(s *surah.Surah) int { return s.Number }, asSurah),
+			"nameArabic":          stringField("Arabic name", func(s *surah.Surah) string { return s.NameArabic }, asSurah),
+			"nameLatin":           stringField("Latin name", func(s *surah.Surah) string { return s.NameLatin }, asSurah),
+			"nameTransliteration": stringField("Transliterated name", func(s *surah.Surah) string { return s.NameTransliteration }, asSurah),
+			"numberOfAyahs":       intField("Number of ayahs", func(s *surah.Surah) int { return s.NumberOfAyahs }, asSurah),
+			"revelationType":      stringField("meccan or medinan", func(s *surah.Surah) string { return s.RevelationType }, asSurah),
+		},
+	})
+
+	ayahType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Ayah",
+		Description: "A single ayah with its Arabic text and translations.",
+		Fields: graphql.Fields{
+			"id":            intField("Global ayah ID (1-6236)", func(a *ayah.Ayah) int { return a.ID }, asAyah),
+			"key":           stringField("surah:ayah key, e.g. 2:255", ayahKey, asAyah),
+			"numberInSurah": intField("Ayah number within its surah", func(a *ayah.Ayah) int { return a.NumberInSurah }, asAyah),
+			"textUthmani":   stringField("Arabic text in Uthmani script", func(a *ayah.Ayah) string { return a.TextUthmani }, asAyah),
+			"juzNumber":     intField("Juz containing the ayah", func(a *ayah.Ayah) int { return a.JuzNumber }, asAyah),
+			"translation": &graphql.Field{
+				Type:        graphql.String,
+				Description: "Translation in the given language",
+				Args: graphql.FieldConfigArgument{
+					"lang": {Type: graphql.String, DefaultValue: "id", Description: "id or en"},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					lang, _ := p.Args["lang"].(string)
+					return translation(asAyah(p.Source), lang)
+				},
+			},
+			"sajda": &graphql.Field{
+				Type:        graphql.String,
+				Description: "Sajda type (recommended or obligatory) when the ayah has a prostration",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if s := asAyah(p.Source).SajdaType; s != nil {
+						return *s, nil
+					}
+					return nil, nil
+				},
+			},
+		},
+	})
+
+	juzType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Juz",
+		Description: "One of the 30 juz.",
+		Fields: graphql.Fields{
+			"number":      intField("Juz number (1-30)", func(j *juz.Juz) int { return j.JuzNumber }, asJuz),
+			"firstAyahId": intField("Global ID of the first ayah", func(j *juz.Juz) int { return j.FirstAyahID }, asJuz),
+			"lastAyahId":  intField("Global ID of the last ayah", func(j *juz.Juz) int { return j.LastAyahID }, asJuz),
+			"totalAyahs":  intField("Number of ayahs", func(j *juz.Juz) int { return j.TotalAyahs }, asJuz),
+		},
+	})
+
+	searchResultType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "SearchResult",
+		Description: "An ayah matching a search query.",
+		Fields: graphql.Fields{
+			"id":            intField("Global ayah ID", func(r *search.Result) int { return r.ID }, asResult),
+			"key":           stringField("surah:ayah key", func(r *search.Result) string { return fmt.Sprintf("%d:%d", r.SurahID, r.NumberInSurah) }, asResult),
+			"numberInSurah": intField("Ayah number within its surah", func(r *search.Result) int { return r.NumberInSurah }, asResult),
+			"textUthmani":   stringField("Arabic text in Uthmani script", func(r *search.Result) string { return r.TextUthmani }, asResult),
+			"translation":   stringField("Translation in the search language", func(r *search.Result) string { return r.Translation }, asResult),
+			"juzNumber":     intField("Juz containing the ayah", func(r *search.Result) int { return r.JuzNumber }, asResult),
+			"surah": &graphql.Field{
+				Type: surahType,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return loadersFrom(p.Context).surah(p.Context, asResult(p.Source).SurahID)
+				},
+			},
+			"ayah": &graphql.Field{
+				Type:        ayahType,
+				Description: "The full ayah, with every translation",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return loadersFrom(p.Context).ayah(p.Context, asResult(p.Source).ID), nil
+				},
+			},
+		},
+	})
+
+	searchPageType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SearchPage",
+		Fields: graphql.Fields{
+			"total": intField("Total matches", func(s *searchPage) int { return s.Total }, asSearchPage),
+			"page":  intField("Page number", func(s *searchPage) int { return s.Page }, asSearchPage),
+			"limit": intField("Results per page", func(s *searchPage) int { return s.Limit }, asSearchPage),
+			"results": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(searchResultType))),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return asSearchPage(p.Source).Results, nil
+				},
+			},
+		},
+	})
+
+	ayahList := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(ayahType)))
+	surahList := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(surahType)))
+	juzList := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(juzType)))
+
+	surahType.AddFieldConfig("ayahs", &graphql.Field{
+		Type:        ayahList,
+		Description: "Ayahs of the surah, optionally limited to numbers from..to",
+		Args: graphql.FieldConfigArgument{
+			"from": {Type: graphql.Int},
+			"to":   {Type: graphql.Int},
+		},
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			s := asSurah(p.Source)
+			from, to := 1, s.NumberOfAyahs
+			if v, ok := p.Args["from"].(int); ok {
+				from = v
+			}
+			if v, ok := p.Args["to"].(int); ok {
+				to = v
+			}
+			if from < 1 || to > s.NumberOfAyahs || from > to {
+				return nil, fmt.Errorf("ayah range must be within 1-%d", s.NumberOfAyahs)
+			}
+			first, _ := reference.GlobalID(s.ID, from)
+			last, _ := reference.GlobalID(s.ID, to)
+			return loadersFrom(p.Context).ayahSpan(p.Context, ayahSpan{first, last}), nil
+		},
+	})
+	surahType.AddFieldConfig("juzs", &graphql.Field{
+		Type:        juzList,
+		Description: "Juz the surah spans",
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			s := asSurah(p.Source)
+			all, err := loadersFrom(p.Context).allJuzs(p.Context)
+			if err != nil {
+				return nil, err
+			}
+			first, _ := reference.GlobalID(s.ID, 1)
+			last, _ := reference.GlobalID(s.ID, s.NumberOfAyahs)
+			var result []juz.Juz
+			for _, j := range all {
+				if j.FirstAyahID <= last && j.LastAyahID >= first {
+					result = append(result, j)
+				}
+			}
+			return result, nil
+		},
+	})
+
+	ayahType.AddFieldConfig("surah", &graphql.Field{
+		Type: graphql.NewNonNull(surahType),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return loadersFrom(p.Context).surah(p.Context, asAyah(p.Source).SurahID)
+		},
+	})
+	ayahType.AddFieldConfig("juz", &graphql.Field{
+		Type: graphql.NewNonNull(juzType),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return loadersFrom(p.Context).juz(p.Context, asAyah(p.Source).JuzNumber)
+		},
+	})
+	ayahType.AddFieldConfig("prev", &graphql.Field{
+		Type:        ayahType,
+		Description: "Previous ayah in mushaf order; null for 1:1",
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return neighbour(p, -1), nil
+		},
+	})
+	ayahType.AddFieldConfig("next", &graphql.Field{
+		Type:        ayahType,
+		Description: "Next ayah in mushaf order; null for 114:6",
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return neighbour(p, 1), nil
+		},
+	})
+
+	juzType.AddFieldConfig("firstAyah", &graphql.Field{
+		Type: graphql.NewNonNull(ayahType),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return loadersFrom(p.Context).ayah(p.Context, asJuz(p.Source).FirstAyahID), nil
+		},
+	})
+	juzType.AddFieldConfig("lastAyah", &graphql.Field{
+		Type: graphql.NewNonNull(ayahType),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return loadersFrom(p.Context).ayah(p.Context, asJuz(p.Source).LastAyahID), nil
+		},
+	})
+	juzType.AddFieldConfig("ayahs", &graphql.Field{
+		Type:        ayahList,
+		Description: "Ayahs of the juz in mushaf order, paginated",
+		Args: graphql.FieldConfigArgument{
+			"limit":  {Type: graphql.Int, DefaultValue: defaultJuzAyahs},
+			"offset": {Type: graphql.Int, DefaultValue: 0},
+		},
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			j := asJuz(p.Source)
+			limit, _ := p.Args["limit"].(int)
+			offset, _ := p.Args["offset"].(int)
+			if limit < 1 || offset < 0 {
+				return nil, errors.New("limit must be positive and offset non-negative")
+			}
+			first := j.FirstAyahID + offset
+			if first > j.LastAyahID {
+				return []ayah.Ayah{}, nil
+			}
+			last := min(first+limit-1, j.LastAyahID)
+			return loadersFrom(p.Context).ayahSpan(p.Context, ayahSpan{first, last}), nil
+		},
+	})
+	juzType.AddFieldConfig("surahs", &graphql.Field{
+		Type:        surahList,
+		Description: "Surahs with at least one ayah in the juz",
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			j := asJuz(p.Source)
+			firstSurah, _, _ := reference.Locate(j.FirstAyahID)
+			lastSurah, _, _ := reference.Locate(j.LastAyahID)
+			l := loadersFrom(p.Context)
+			var result []*surah.Surah
+			for id := firstSurah; id <= lastSurah; id++ {
+				s, err := l.surah(p.Context, id)
+				if err != nil {
+					return nil, err
+				}
+				if s != nil {
+					result = append(result, s)
+				}
+			}
+			return result, nil
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"surahs": &graphql.Field{
+				Type:        surahList,
+				Description: "All surahs, optionally filtered by revelation type",
+				Args: graphql.FieldConfigArgument{
+					"revelationType": {Type: graphql.String, Description: "meccan or medinan"},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					all, err := loadersFrom(p.Context).allSurahs(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					revelation, ok := p.Args["revelationType"].(string)
+					if !ok {
+						return all, nil
+					}
+					if revelation != "meccan" && revelation != "medinan" {
+						return nil, errors.New("revelationType must be meccan or medinan")
+					}
+					var result []surah.Surah
+					for _, s := range all {
+						if strings.EqualFold(s.RevelationType, revelation) {
+							result = append(result, s)
+						}
+					}
+					return result, nil
+				},
+			},
+			"surah": &graphql.Field{
+				Type: surahType,
+				Args: graphql.FieldConfigArgument{
+					"id": {Type: graphql.NewNonNull(graphql.Int), Description: "Surah ID (1-114)"},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return loadersFrom(p.Context).surah(p.Context, p.Args["id"].(int))
+				},
+			},
+			"ayah": &graphql.Field{
+				Type:        ayahType,
+				Description: "An ayah by global ID or surah:ayah key; pass exactly one",
+				Args: graphql.FieldConfigArgument{
+					"id":  {Type: graphql.Int, Description: "Global ayah ID (1-6236)"},
+					"key": {Type: graphql.String, Description: "surah:ayah key, e.g. 2:255"},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					id, hasID := p.Args["id"].(int)
+					key, hasKey := p.Args["key"].(string)
+					if hasID == hasKey {
+						return nil, errors.New("pass exactly one of id or key")
+					}
+					if hasKey {
+						var ok bool
+						if id, ok = parseKey(key); !ok {
+							return nil, fmt.Errorf("invalid ayah key %q", key)
+						}
+					}
+					return loadersFrom(p.Context).ayah(p.Context, id), nil
+				},
+			},
+			"juzs": &graphql.Field{
+				Type: juzList,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return loadersFrom(p.Context).allJuzs(p.Context)
+				},
+			},
+			"juz": &graphql.Field{
+				Type: juzType,
+				Args: graphql.FieldConfigArgument{
+					"number": {Type: graphql.NewNonNull(graphql.Int), Description: "Juz number (1-30)"},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return loadersFrom(p.Context).juz(p.Context, p.Args["number"].(int))
+				},
+			},
+			"search": &graphql.Field{
+				Type:        graphql.NewNonNull(searchPageType),
+				Description: "Full-text search over the Arabic text and translations",
+				Args: graphql.FieldConfigArgument{
+					"query":   {Type: graphql.NewNonNull(graphql.String)},
+					"lang":    {Type: graphql.String, DefaultValue: "id", Description: "id or en"},
+					"surahId": {Type: graphql.Int},
+					"juz":     {Type: graphql.Int},
+					"page":    {Type: graphql.Int, DefaultValue: 1},
+					"limit":   {Type: graphql.Int, DefaultValue: defaultSearchLimit},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					params := search.Params{Query: strings.TrimSpace(p.Args["query"].(string))}
+					params.Lang, _ = p.Args["lang"].(string)
+					params.SurahID, _ = p.Args["surahId"].(int)
+					params.Juz, _ = p.Args["juz"].(int)
+					params.Page, _ = p.Args["page"].(int)
+					params.Limit, _ = p.Args["limit"].(int)
+					if params.Query == "" {
+						return nil, errors.New("query is required")
+					}
+					if params.Lang != "id" && params.Lang != "en" {
+						return nil, errors.New("lang must be id or en")
+					}
+					if params.Page < 1 || params.Limit < 1 || params.Limit > maxSearchLimit {
+						return nil, fmt.Errorf("page must be positive and limit within 1-%d", maxSearchLimit)
+					}
+					results, total, err := searchSvc.Search(p.Context, params)
+					if err != nil {
+						return nil, err
+					}
+					return &searchPage{Total: total, Page: params.Page, Limit: params.Limit, Results: results}, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// intField and stringField build scalar fields read from the source value
+// unwrapped by as.
+func intField[T any](description string, get func(*T) int, as func(any) *T) *graphql.Field {
+	return &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.Int),
+		Description: description,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return get(as(p.Source)), nil
+		},
+	}
+}
+
+func stringField[T any](description string, get func(*T) string, as func(any) *T) *graphql.Field {
+	return &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.String),
+		Description: description,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return get(as(p.Source)), nil
+		},
+	}
+}
+
+// Lists resolve to slices of values while single lookups return pointers;
+// the as* helpers accept either.
+
+func asSurah(source any) *surah.Surah {
+	if s, ok := source.(surah.Surah); ok {
+		return &s
+	}
+	return source.(*surah.Surah)
+}
+
+func asAyah(source any) *ayah.Ayah {
+	if a, ok := source.(ayah.Ayah); ok {
+		return &a
+	}
+	return source.(*ayah.Ayah)
+}
+
+func asJuz(source any) *juz.Juz {
+	if j, ok := source.(juz.Juz); ok {
+		return &j
+	}
+	return source.(*juz.Juz)
+}
+
+func asResult(source any) *search.Result {
+	if r, ok := source.(search.Result); ok {
+		return &r
+	}
+	return source.(*search.Result)
+}
+
+func asSearchPage(source any) *searchPage {
+	return source.(*searchPage)
+}
+
+func ayahKey(a *ayah.Ayah) string {
+	return strconv.Itoa(a.SurahID) + ":" + strconv.Itoa(a.NumberInSurah)
+}
+
+// parseKey converts a surah:ayah key to a global ayah ID.
+func parseKey(key string) (int, bool) {
+	rawSurah, rawNumber, ok := strings.Cut(strings.TrimSpace(key), ":")
+	if !ok {
+		return 0, false
+	}
+	surahID, err := strconv.Atoi(rawSurah)
+	if err != nil {
+		return 0, false
+	}
+	number, err := strconv.Atoi(rawNumber)
+	if err != nil {
+		return 0, false
+	}
+	return reference.GlobalID(surahID, number)
+}
+
+func translation(a *ayah.Ayah, lang string) (string, error) {
+	switch lang {
+	case "id":
+		return a.TranslationIdo, nil
+	case "en":
+		return a.TranslationEn, nil
+	}
+	return "", errors.New("lang must be id or en")
+}
+
+// neighbour returns a thunk for the ayah delta positions away, or nil at
+// either end of the mushaf.
+func neighbour(p graphql.ResolveParams, delta int) any {
+	id := asAyah(p.Source).ID + delta
+	if id < 1 || id > reference.TotalAyahs {
+		return nil
+	}
+	return loadersFrom(p.Context).ayah(p.Context, id)
+}
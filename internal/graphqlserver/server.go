@@ -0,0 +1,131 @@
+// Package graphqlserver serves the Quran data as a GraphQL API. Every field
+// resolves through the domain services; relations between surahs, ayahs and
+// juz are batched per request so a query costs a handful of service calls
+// however many objects it touches.
+package graphqlserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/domain/juz"
+	"quran-api-go/internal/domain/search"
+	"quran-api-go/internal/domain/surah"
+)
+
+// maxBodyBytes bounds POST bodies; real queries are a few kilobytes.
+const maxBodyBytes = 64 << 10
+
+// Server is an http.Handler for GET and POST /graphql.
+type Server struct {
+	schema   graphql.Schema
+	limits   Limits
+	surahSvc surah.SurahService
+	ayahSvc  ayah.AyahService
+	juzSvc   juz.JuzService
+}
+
+// New builds the GraphQL server. Zero fields in limits disable that check.
+func New(
+	surahSvc surah.SurahService,
+	ayahSvc ayah.AyahService,
+	juzSvc juz.JuzService,
+	searchSvc search.SearchService,
+	limits Limits,
+) (*Server, error) {
+	schema, err := newSchema(searchSvc)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		schema:   schema,
+		limits:   limits,
+		surahSvc: surahSvc,
+		ayahSvc:  ayahSvc,
+		juzSvc:   juzSvc,
+	}, nil
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// ServeHTTP accepts the query as JSON in a POST body or as query, variables
+// and operationName URL parameters on GET. Malformed requests get 400;
+// everything else, including invalid or over-limit queries, is answered with
+// 200 and a GraphQL errors list.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query()
+		req.Query = q.Get("query")
+		req.OperationName = q.Get("operationName")
+		if raw := q.Get("variables"); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &req.Variables); err != nil {
+				writeError(w, http.StatusBadRequest, "variables must be a JSON object")
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes)).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "request body must be a JSON object with a query")
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeError(w, http.StatusMethodNotAllowed, "use GET or POST")
+		return
+	}
+	if req.Query == "" {
+		writeError(w, http.StatusBadRequest, "query is required")
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), loadersKey{}, newLoaders(s.surahSvc, s.ayahSvc, s.juzSvc))
+	writeJSON(w, http.StatusOK, s.execute(ctx, req))
+}
+
+// execute mirrors graphql.Do, with the limit check between validation and
+// execution.
+func (s *Server) execute(ctx context.Context, req request) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	if validation := graphql.ValidateDocument(&s.schema, doc, nil); !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+	if err := checkLimits(doc, req.OperationName, req.Variables, s.limits); err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, &graphql.Result{Errors: gqlerrors.FormatErrors(errors.New(message))})
+}
+
+func writeJSON(w http.ResponseWriter, status int, result *graphql.Result) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(result)
+}
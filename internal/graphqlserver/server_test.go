@@ -0,0 +1,333 @@
+package graphqlserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/domain/juz"
+	"quran-api-go/internal/domain/search"
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/internal/graphqlserver"
+	"quran-api-go/pkg/reference"
+)
+
+// fakeQuran serves generated data for every surah and ayah and counts the
+// service calls the loaders make.
+type fakeQuran struct {
+	surahCalls, rangeCalls, idsCalls, juzCalls, searchCalls int
+}
+
+var fakeJuzs = []juz.Juz{
+	{ID: 1, JuzNumber: 1, FirstAyahID: 1, LastAyahID: 148, TotalAyahs: 148},
+	{ID: 2, JuzNumber: 2, FirstAyahID: 149, LastAyahID: 259, TotalAyahs: 111},
+	{ID: 3, JuzNumber: 3, FirstAyahID: 260, LastAyahID: 385, TotalAyahs: 126},
+}
+
+func fakeAyah(id int) ayah.Ayah {
+	surahID, number, _ := reference.Locate(id)
+	juzNumber := 3
+	for _, j := range fakeJuzs {
+		if id >= j.FirstAyahID && id <= j.LastAyahID {
+			juzNumber = j.JuzNumber
+		}
+	}
+	return ayah.Ayah{
+		ID:             id,
+		SurahID:        surahID,
+		NumberInSurah:  number,
+		TextUthmani:    "arabic " + strconv.Itoa(id),
+		TranslationIdo: "indo " + strconv.Itoa(id),
+		TranslationEn:  "en " + strconv.Itoa(id),
+		JuzNumber:      juzNumber,
+	}
+}
+
+func (f *fakeQuran) GetAll(context.Context) ([]surah.Surah, error) {
+	f.surahCalls++
+	surahs := make([]surah.Surah, 114)
+	for i := range surahs {
+		id := i + 1
+		revelation := "meccan"
+		if id == 2 || id == 3 {
+			revelation = "medinan"
+		}
+		surahs[i] = surah.Surah{
+			ID:             id,
+			Number:         id,
+			NameLatin:      reference.SurahName(id, "id"),
+			NumberOfAyahs:  reference.AyahCount(id),
+			RevelationType: revelation,
+		}
+	}
+	return surahs, nil
+}
+
+func (f *fakeQuran) GetByID(context.Context, int) (*surah.Surah, error) { panic("not batched") }
+func (f *fakeQuran) GetByRevelationType(context.Context, string) ([]surah.Surah, error) {
+	panic("not batched")
+}
+func (f *fakeQuran) Search(context.Context, string, int) ([]surah.Match, error) {
+	panic("not batched")
+}
+
+type fakeAyahs struct{ *fakeQuran }
+
+func (f fakeAyahs) GetByIDs(_ context.Context, ids []int) ([]ayah.Ayah, error) {
+	f.idsCalls++
+	var result []ayah.Ayah
+	for _, id := range ids {
+		result = append(result, fakeAyah(id))
+	}
+	return result, nil
+}
+
+func (f fakeAyahs) GetByIDRange(_ context.Context, fromID, toID, limit, offset int) ([]ayah.Ayah, error) {
+	f.rangeCalls++
+	var result []ayah.Ayah
+	for id := fromID + offset; id <= toID && len(result) < limit; id++ {
+		result = append(result, fakeAyah(id))
+	}
+	return result, nil
+}
+
+func (f fakeAyahs) GetByID(context.Context, int) (*ayah.Ayah, error) { panic("not batched") }
+func (f fakeAyahs) GetBySurah(context.Context, int, int, int) ([]ayah.Ayah, error) {
+	panic("not batched")
+}
+func (f fakeAyahs) GetBySurahAndNumber(context.Context, int, int) (*ayah.Ayah, error) {
+	panic("not batched")
+}
+func (f fakeAyahs) GetRandom(context.Context, int) (*ayah.Ayah, error) { panic("not used") }
+func (f fakeAyahs) GetSajda(context.Context) ([]ayah.SajdaAyah, error) { panic("not used") }
+func (f fakeAyahs) Query(context.Context, ayah.Filter) ([]ayah.Ayah, int, error) {
+	panic("not used")
+}
+
+type fakeJuzService struct{ *fakeQuran }
+
+func (f fakeJuzService) GetAll(context.Context) ([]juz.Juz, error) {
+	f.juzCalls++
+	return fakeJuzs, nil
+}
+
+func (f fakeJuzService) GetByNumber(context.Context, int) (*juz.Juz, error) { panic("not batched") }
+func (f fakeJuzService) GetAyahsByJuz(context.Context, int, int, int) ([]juz.JuzAyah, error) {
+	panic("not batched")
+}
+func (f fakeJuzService) GetSurahsByJuz(context.Context, int) ([]juz.JuzSurah, error) {
+	panic("not batched")
+}
+
+type fakeSearch struct{ *fakeQuran }
+
+func (f fakeSearch) Search(_ context.Context, p search.Params) ([]search.Result, int, error) {
+	f.searchCalls++
+	var results []search.Result
+	for _, id := range []int{8, 262} {
+		a := fakeAyah(id)
+		results = append(results, search.Result{ID: id, SurahID: a.SurahID, NumberInSurah: a.NumberInSurah, Translation: p.Query})
+	}
+	return results, len(results), nil
+}
+
+func newServer(t *testing.T, limits graphqlserver.Limits) (*graphqlserver.Server, *fakeQuran) {
+	t.Helper()
+	f := &fakeQuran{}
+	srv, err := graphqlserver.New(f, fakeAyahs{f}, fakeJuzService{f}, fakeSearch{f}, limits)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return srv, f
+}
+
+type gqlResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func post(t *testing.T, srv http.Handler, query string, variables map[string]any) (int, gqlResponse) {
+	t.Helper()
+	body, _ := json.Marshal(map[string]any{"query": query, "variables": variables})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, req)
+	var resp gqlResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode %q: %v", w.Body.String(), err)
+	}
+	return w.Code, resp
+}
+
+// field walks a decoded response along path; numeric segments index lists.
+func field(t *testing.T, v any, path ...string) any {
+	t.Helper()
+	for _, p := range path {
+		if i, err := strconv.Atoi(p); err == nil {
+			v = v.([]any)[i]
+		} else {
+			v = v.(map[string]any)[p]
+		}
+	}
+	return v
+}
+
+func TestServer_SurahWithAyahs(t *testing.T) {
+	srv, f := newServer(t, graphqlserver.Limits{MaxDepth: 8, MaxComplexity: 5000})
+	code, resp := post(t, srv, `{
+		surah(id: 2) {
+			nameLatin
+			numberOfAyahs
+			juzs { number }
+			ayahs(from: 1, to: 3) { key translation(lang: "en") surah { id } juz { number } }
+		}
+	}`, nil)
+	if code != http.StatusOK || len(resp.Errors) > 0 {
+		t.Fatalf("got %d %+v", code, resp.Errors)
+	}
+	if got := field(t, resp.Data, "surah", "numberOfAyahs"); got != float64(286) {
+		t.Errorf("numberOfAyahs = %v", got)
+	}
+	ayahs := field(t, resp.Data, "surah", "ayahs").([]any)
+	if len(ayahs) != 3 || field(t, ayahs, "2", "key") != "2:3" || field(t, ayahs, "0", "translation") != "en 8" {
+		t.Errorf("ayahs = %v", ayahs)
+	}
+	if got := len(field(t, resp.Data, "surah", "juzs").([]any)); got != 3 {
+		t.Errorf("juzs = %d, want 3", got)
+	}
+	if f.surahCalls != 1 || f.juzCalls != 1 || f.rangeCalls != 1 || f.idsCalls != 0 {
+		t.Errorf("calls: surah=%d juz=%d range=%d ids=%d", f.surahCalls, f.juzCalls, f.rangeCalls, f.idsCalls)
+	}
+}
+
+func TestServer_BatchesAyahLookups(t *testing.T) {
+	srv, f := newServer(t, graphqlserver.Limits{})
+	code, resp := post(t, srv, `{
+		a: ayah(id: 1) { key next { key } }
+		b: ayah(key: "2:255") { key prev { key } next { key } }
+		c: ayah(id: 6236) { next { key } }
+	}`, nil)
+	if code != http.StatusOK || len(resp.Errors) > 0 {
+		t.Fatalf("got %d %+v", code, resp.Errors)
+	}
+	if got := field(t, resp.Data, "b", "prev", "key"); got != "2:254" {
+		t.Errorf("prev = %v", got)
+	}
+	if got := field(t, resp.Data, "a", "next", "key"); got != "1:2" {
+		t.Errorf("next = %v", got)
+	}
+	if got := field(t, resp.Data, "c", "next"); got != nil {
+		t.Errorf("next of the last ayah = %v, want null", got)
+	}
+	// One batch per tree level: the three roots, then their neighbours.
+	if f.idsCalls != 2 || f.rangeCalls != 0 {
+		t.Errorf("calls: ids=%d range=%d, want 2 and 0", f.idsCalls, f.rangeCalls)
+	}
+}
+
+func TestServer_JuzAndSearch(t *testing.T) {
+	srv, f := newServer(t, graphqlserver.Limits{MaxDepth: 8, MaxComplexity: 5000})
+	code, resp := post(t, srv, `query($q: String!) {
+		juz(number: 2) { firstAyah { key } lastAyah { key } surahs { id } ayahs(limit: 2, offset: 1) { id } }
+		search(query: $q, lang: "en") { total results { key translation surah { nameLatin } ayah { textUthmani } } }
+	}`, map[string]any{"q": "patience"})
+	if code != http.StatusOK || len(resp.Errors) > 0 {
+		t.Fatalf("got %d %+v", code, resp.Errors)
+	}
+	if got := field(t, resp.Data, "juz", "firstAyah", "key"); got != "2:142" {
+		t.Errorf("firstAyah = %v", got)
+	}
+	if got := field(t, resp.Data, "juz", "ayahs", "0", "id"); got != float64(150) {
+		t.Errorf("ayahs[0] = %v", got)
+	}
+	if got := field(t, resp.Data, "search", "results", "1", "key"); got != "2:255" {
+		t.Errorf("search key = %v", got)
+	}
+	if got := field(t, resp.Data, "search", "results", "0", "translation"); got != "patience" {
+		t.Errorf("search translation = %v", got)
+	}
+	if f.surahCalls != 1 || f.searchCalls != 1 {
+		t.Errorf("calls: surah=%d search=%d", f.surahCalls, f.searchCalls)
+	}
+}
+
+func TestServer_Limits(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"depth", `{ ayah(id: 1) { next { next { next { key } } } } }`, "query depth 5 exceeds the limit of 3"},
+		{"depth through fragments", `{ ayah(id: 1) { ...deep } } fragment deep on Ayah { next { next { key } } }`, "depth 4"},
+		{"complexity", `{ surahs { ayahs { key } } }`, "complexity"},
+		{"complexity from variables", `query($n: Int) { juz(number: 1) { ayahs(limit: $n) { key } } }`, "complexity"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, f := newServer(t, graphqlserver.Limits{MaxDepth: 3, MaxComplexity: 1000})
+			code, resp := post(t, srv, tt.query, map[string]any{"n": 5000})
+			if code != http.StatusOK || len(resp.Errors) != 1 || !strings.Contains(resp.Errors[0].Message, tt.want) {
+				t.Fatalf("got %d %+v, want error containing %q", code, resp.Errors, tt.want)
+			}
+			if resp.Data != nil || f.surahCalls+f.idsCalls+f.rangeCalls+f.juzCalls != 0 {
+				t.Errorf("rejected query was executed")
+			}
+		})
+	}
+
+	t.Run("within limits", func(t *testing.T) {
+		srv, _ := newServer(t, graphqlserver.Limits{MaxDepth: 3, MaxComplexity: 1000})
+		if _, resp := post(t, srv, `{ surahs(revelationType: "medinan") { id } }`, nil); len(resp.Errors) > 0 {
+			t.Fatalf("errors: %+v", resp.Errors)
+		} else if got := len(resp.Data["surahs"].([]any)); got != 2 {
+			t.Errorf("medinan surahs = %d, want 2", got)
+		}
+	})
+}
+
+func TestServer_Requests(t *testing.T) {
+	srv, _ := newServer(t, graphqlserver.Limits{})
+
+	t.Run("GET with variables", func(t *testing.T) {
+		q := url.Values{
+			"query":     {`query($id: Int!) { surah(id: $id) { nameLatin } }`},
+			"variables": {`{"id": 36}`},
+		}
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/graphql?"+q.Encode(), nil))
+		if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), reference.SurahName(36, "id")) {
+			t.Fatalf("got %d %s", w.Code, w.Body.String())
+		}
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		want   int
+	}{
+		{"missing query", http.MethodPost, "/graphql", `{}`, http.StatusBadRequest},
+		{"malformed body", http.MethodPost, "/graphql", `{"query":`, http.StatusBadRequest},
+		{"malformed variables", http.MethodGet, "/graphql?query=%7Bjuzs%7Bnumber%7D%7D&variables=nope", "", http.StatusBadRequest},
+		{"method", http.MethodPut, "/graphql", `{"query":"{ juzs { number } }"}`, http.StatusMethodNotAllowed},
+		{"invalid query", http.MethodPost, "/graphql", `{"query":"{ surah { nope } }"}`, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			srv.ServeHTTP(w, httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body)))
+			if w.Code != tt.want || !strings.Contains(w.Body.String(), `"errors"`) {
+				t.Errorf("got %d %s, want %d with errors", w.Code, w.Body.String(), tt.want)
+			}
+		})
+	}
+}
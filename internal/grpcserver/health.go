@@ -0,0 +1,90 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"quran-api-go/internal/domain/healthcheck"
+	quranv1 "quran-api-go/pkg/pb/quran/v1"
+)
+
+// watchInterval is how often Watch re-runs the readiness check.
+var watchInterval = 5 * time.Second
+
+// healthServer implements grpc.health.v1.Health with the same readiness
+// check as GET /health/ready. It knows the overall server ("") and
+// QuranService, which share one status.
+type healthServer struct {
+	healthpb.UnimplementedHealthServer
+	svc healthcheck.HealthCheckService
+}
+
+func newHealthServer(svc healthcheck.HealthCheckService) *healthServer {
+	return &healthServer{svc: svc}
+}
+
+var healthServices = []string{"", quranv1.QuranService_ServiceDesc.ServiceName}
+
+func (h *healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if !knownService(req.GetService()) {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+	return &healthpb.HealthCheckResponse{Status: h.status(ctx)}, nil
+}
+
+func (h *healthServer) List(ctx context.Context, _ *healthpb.HealthListRequest) (*healthpb.HealthListResponse, error) {
+	serving := h.status(ctx)
+	resp := &healthpb.HealthListResponse{Statuses: map[string]*healthpb.HealthCheckResponse{}}
+	for _, name := range healthServices {
+		resp.Statuses[name] = &healthpb.HealthCheckResponse{Status: serving}
+	}
+	return resp, nil
+}
+
+// Watch sends the current status, then every change until the client goes
+// away. Unknown services report SERVICE_UNKNOWN as the protocol requires.
+func (h *healthServer) Watch(req *healthpb.HealthCheckRequest, stream grpc.ServerStreamingServer[healthpb.HealthCheckResponse]) error {
+	ctx := stream.Context()
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		current := healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+		if knownService(req.GetService()) {
+			current = h.status(ctx)
+		}
+		if current != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: current}); err != nil {
+				return err
+			}
+			last = current
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *healthServer) status(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	if _, err := h.svc.ReadyCheck(ctx); err != nil {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+func knownService(name string) bool {
+	for _, known := range healthServices {
+		if name == known {
+			return true
+		}
+	}
+	return false
+}
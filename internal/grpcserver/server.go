@@ -0,0 +1,356 @@
+// Package grpcserver implements quran.v1.QuranService on top of the domain
+// services, for backend services that talk gRPC.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/domain/healthcheck"
+	"quran-api-go/internal/domain/juz"
+	"quran-api-go/internal/domain/search"
+	"quran-api-go/internal/domain/surah"
+	quranv1 "quran-api-go/pkg/pb/quran/v1"
+	"quran-api-go/pkg/reference"
+)
+
+const (
+	// streamPageSize is how many ayahs each stream reads from the service at
+	// a time; it matches the GetByIDRange cap.
+	streamPageSize = 100
+	maxBatchAyahs  = 100
+	maxSearchLimit = 100
+)
+
+type server struct {
+	quranv1.UnimplementedQuranServiceServer
+
+	surahSvc  surah.SurahService
+	ayahSvc   ayah.AyahService
+	juzSvc    juz.JuzService
+	searchSvc search.SearchService
+}
+
+// New builds a *grpc.Server with QuranService and the standard health
+// service registered. Health reports NOT_SERVING while healthSvc.ReadyCheck
+// fails.
+func New(
+	surahSvc surah.SurahService,
+	ayahSvc ayah.AyahService,
+	juzSvc juz.JuzService,
+	searchSvc search.SearchService,
+	healthSvc healthcheck.HealthCheckService,
+	opts ...grpc.ServerOption,
+) *grpc.Server {
+	srv := grpc.NewServer(opts...)
+	quranv1.RegisterQuranServiceServer(srv, &server{
+		surahSvc:  surahSvc,
+		ayahSvc:   ayahSvc,
+		juzSvc:    juzSvc,
+		searchSvc: searchSvc,
+	})
+	healthpb.RegisterHealthServer(srv, newHealthServer(healthSvc))
+	return srv
+}
+
+func (s *server) ListSurahs(ctx context.Context, req *quranv1.ListSurahsRequest) (*quranv1.ListSurahsResponse, error) {
+	var (
+		surahs []surah.Surah
+		err    error
+	)
+	switch req.GetRevelationType() {
+	case "":
+		surahs, err = s.surahSvc.GetAll(ctx)
+	case "meccan", "medinan":
+		surahs, err = s.surahSvc.GetByRevelationType(ctx, req.GetRevelationType())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "revelation_type must be meccan or medinan")
+	}
+	if err != nil {
+		return nil, internal(err)
+	}
+	resp := &quranv1.ListSurahsResponse{Surahs: make([]*quranv1.Surah, 0, len(surahs))}
+	for i := range surahs {
+		resp.Surahs = append(resp.Surahs, toSurah(&surahs[i]))
+	}
+	return resp, nil
+}
+
+func (s *server) GetSurah(ctx context.Context, req *quranv1.GetSurahRequest) (*quranv1.Surah, error) {
+	id := int(req.GetId())
+	if reference.AyahCount(id) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "surah id must be between 1 and 114, got %d", id)
+	}
+	result, err := s.surahSvc.GetByID(ctx, id)
+	if err := notFound(err, result == nil, "surah %d not found", id); err != nil {
+		return nil, err
+	}
+	return toSurah(result), nil
+}
+
+func (s *server) GetAyah(ctx context.Context, req *quranv1.GetAyahRequest) (*quranv1.Ayah, error) {
+	var id int
+	switch ref := req.GetAyah().(type) {
+	case *quranv1.GetAyahRequest_Id:
+		id = int(ref.Id)
+		if id < 1 || id > reference.TotalAyahs {
+			return nil, status.Errorf(codes.InvalidArgument, "global ayah id must be between 1 and %d, got %d", reference.TotalAyahs, id)
+		}
+	case *quranv1.GetAyahRequest_Key:
+		var ok bool
+		if id, ok = parseKey(ref.Key); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid ayah key %q", ref.Key)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "id or key is required")
+	}
+	result, err := s.ayahSvc.GetByID(ctx, id)
+	if err := notFound(err, result == nil, "ayah %d not found", id); err != nil {
+		return nil, err
+	}
+	return toAyah(result), nil
+}
+
+func (s *server) BatchGetAyahs(ctx context.Context, req *quranv1.BatchGetAyahsRequest) (*quranv1.BatchGetAyahsResponse, error) {
+	if len(req.GetIds()) > maxBatchAyahs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d ids per request", maxBatchAyahs)
+	}
+	ids := make([]int, len(req.GetIds()))
+	for i, id := range req.GetIds() {
+		ids[i] = int(id)
+	}
+	ayahs, err := s.ayahSvc.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, internal(err)
+	}
+	resp := &quranv1.BatchGetAyahsResponse{Ayahs: make([]*quranv1.Ayah, 0, len(ayahs))}
+	for i := range ayahs {
+		resp.Ayahs = append(resp.Ayahs, toAyah(&ayahs[i]))
+	}
+	return resp, nil
+}
+
+func (s *server) StreamSurahAyahs(req *quranv1.StreamSurahAyahsRequest, stream grpc.ServerStreamingServer[quranv1.Ayah]) error {
+	surahID := int(req.GetSurahId())
+	count := reference.AyahCount(surahID)
+	if count == 0 {
+		return status.Errorf(codes.InvalidArgument, "surah id must be between 1 and 114, got %d", surahID)
+	}
+	from, to := int(req.GetFrom()), int(req.GetTo())
+	if from == 0 {
+		from = 1
+	}
+	if to == 0 {
+		to = count
+	}
+	if from < 1 || to > count || from > to {
+		return status.Errorf(codes.InvalidArgument, "ayah range must be within 1-%d", count)
+	}
+	first, _ := reference.GlobalID(surahID, from)
+	last, _ := reference.GlobalID(surahID, to)
+	return s.streamRange(stream, first, last)
+}
+
+func (s *server) RandomAyah(ctx context.Context, req *quranv1.RandomAyahRequest) (*quranv1.Ayah, error) {
+	surahID := int(req.GetSurahId())
+	if surahID != 0 && reference.AyahCount(surahID) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "surah id must be 0 or between 1 and 114, got %d", surahID)
+	}
+	result, err := s.ayahSvc.GetRandom(ctx, surahID)
+	if err := notFound(err, result == nil, "no ayah found"); err != nil {
+		return nil, err
+	}
+	return toAyah(result), nil
+}
+
+func (s *server) ListJuzs(ctx context.Context, _ *quranv1.ListJuzsRequest) (*quranv1.ListJuzsResponse, error) {
+	juzs, err := s.juzSvc.GetAll(ctx)
+	if err != nil {
+		return nil, internal(err)
+	}
+	resp := &quranv1.ListJuzsResponse{Juzs: make([]*quranv1.Juz, 0, len(juzs))}
+	for i := range juzs {
+		resp.Juzs = append(resp.Juzs, toJuz(&juzs[i]))
+	}
+	return resp, nil
+}
+
+func (s *server) GetJuz(ctx context.Context, req *quranv1.GetJuzRequest) (*quranv1.Juz, error) {
+	result, err := s.getJuz(ctx, int(req.GetNumber()))
+	if err != nil {
+		return nil, err
+	}
+	return toJuz(result), nil
+}
+
+func (s *server) StreamJuzAyahs(req *quranv1.StreamJuzAyahsRequest, stream grpc.ServerStreamingServer[quranv1.Ayah]) error {
+	result, err := s.getJuz(stream.Context(), int(req.GetNumber()))
+	if err != nil {
+		return err
+	}
+	return s.streamRange(stream, result.FirstAyahID, result.LastAyahID)
+}
+
+func (s *server) Search(ctx context.Context, req *quranv1.SearchRequest) (*quranv1.SearchResponse, error) {
+	params := search.Params{
+		Query:   strings.TrimSpace(req.GetQuery()),
+		Lang:    req.GetLang(),
+		SurahID: int(req.GetSurahId()),
+		Juz:     int(req.GetJuz()),
+		Page:    int(req.GetPage()),
+		Limit:   int(req.GetLimit()),
+	}
+	if params.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	if params.Lang == "" {
+		params.Lang = "id"
+	}
+	if params.Lang != "id" && params.Lang != "en" {
+		return nil, status.Error(codes.InvalidArgument, "lang must be id or en")
+	}
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 20
+	}
+	if params.Page < 1 || params.Limit < 1 || params.Limit > maxSearchLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "page must be positive and limit within 1-%d", maxSearchLimit)
+	}
+
+	results, total, err := s.searchSvc.Search(ctx, params)
+	if err != nil {
+		return nil, internal(err)
+	}
+	resp := &quranv1.SearchResponse{
+		Total:   int32(total),
+		Page:    int32(params.Page),
+		Limit:   int32(params.Limit),
+		Results: make([]*quranv1.SearchResult, 0, len(results)),
+	}
+	for _, r := range results {
+		resp.Results = append(resp.Results, &quranv1.SearchResult{
+			Id:             int32(r.ID),
+			SurahId:        int32(r.SurahID),
+			SurahNameLatin: r.SurahInfo.NameLatin,
+			NumberInSurah:  int32(r.NumberInSurah),
+			Key:            strconv.Itoa(r.SurahID) + ":" + strconv.Itoa(r.NumberInSurah),
+			TextUthmani:    r.TextUthmani,
+			Translation:    r.Translation,
+			JuzNumber:      int32(r.JuzNumber),
+		})
+	}
+	return resp, nil
+}
+
+func (s *server) getJuz(ctx context.Context, number int) (*juz.Juz, error) {
+	if number < 1 || number > 30 {
+		return nil, status.Errorf(codes.InvalidArgument, "juz number must be between 1 and 30, got %d", number)
+	}
+	result, err := s.juzSvc.GetByNumber(ctx, number)
+	if err := notFound(err, result == nil, "juz %d not found", number); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// streamRange sends the ayahs first..last in mushaf order, reading one page
+// at a time so a whole surah or juz is never held in memory at once.
+func (s *server) streamRange(stream grpc.ServerStreamingServer[quranv1.Ayah], first, last int) error {
+	ctx := stream.Context()
+	for offset := 0; offset <= last-first; offset += streamPageSize {
+		ayahs, err := s.ayahSvc.GetByIDRange(ctx, first, last, streamPageSize, offset)
+		if err != nil {
+			return internal(err)
+		}
+		for i := range ayahs {
+			if err := stream.Send(toAyah(&ayahs[i])); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// notFound maps a service lookup result to a gRPC status: nil on success,
+// NOT_FOUND for ErrNotFound or a missing row, INTERNAL otherwise.
+func notFound(err error, missing bool, format string, args ...any) error {
+	if errors.Is(err, domain.ErrNotFound) || (err == nil && missing) {
+		return status.Errorf(codes.NotFound, format, args...)
+	}
+	if err != nil {
+		return internal(err)
+	}
+	return nil
+}
+
+// internal hides storage errors from clients; context errors keep their
+// own codes so cancelled calls are not reported as server faults.
+func internal(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// parseKey converts a surah:ayah key to a global ayah ID.
+func parseKey(key string) (int, bool) {
+	rawSurah, rawNumber, ok := strings.Cut(strings.TrimSpace(key), ":")
+	if !ok {
+		return 0, false
+	}
+	surahID, err := strconv.Atoi(rawSurah)
+	if err != nil {
+		return 0, false
+	}
+	number, err := strconv.Atoi(rawNumber)
+	if err != nil {
+		return 0, false
+	}
+	return reference.GlobalID(surahID, number)
+}
+
+func toSurah(s *surah.Surah) *quranv1.Surah {
+	return &quranv1.Surah{
+		Id:                  int32(s.ID),
+		Number:              int32(s.Number),
+		NameArabic:          s.NameArabic,
+		NameLatin:           s.NameLatin,
+		NameTransliteration: s.NameTransliteration,
+		NumberOfAyahs:       int32(s.NumberOfAyahs),
+		RevelationType:      s.RevelationType,
+	}
+}
+
+func toAyah(a *ayah.Ayah) *quranv1.Ayah {
+	return &quranv1.Ayah{
+		Id:            int32(a.ID),
+		SurahId:       int32(a.SurahID),
+		NumberInSurah: int32(a.NumberInSurah),
+		Key:           strconv.Itoa(a.SurahID) + ":" + strconv.Itoa(a.NumberInSurah),
+		TextUthmani:   a.TextUthmani,
+		TranslationId: a.TranslationIdo,
+		TranslationEn: a.TranslationEn,
+		JuzNumber:     int32(a.JuzNumber),
+		SajdaType:     a.SajdaType,
+	}
+}
+
+func toJuz(j *juz.Juz) *quranv1.Juz {
+	return &quranv1.Juz{
+		Number:      int32(j.JuzNumber),
+		FirstAyahId: int32(j.FirstAyahID),
+		LastAyahId:  int32(j.LastAyahID),
+		TotalAyahs:  int32(j.TotalAyahs),
+	}
+}
@@ -0,0 +1,329 @@
+package grpcserver_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/domain/healthcheck"
+	"quran-api-go/internal/domain/juz"
+	"quran-api-go/internal/domain/search"
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/internal/grpcserver"
+	quranv1 "quran-api-go/pkg/pb/quran/v1"
+	"quran-api-go/pkg/reference"
+)
+
+type fakeSurahs struct{}
+
+func (fakeSurahs) GetAll(context.Context) ([]surah.Surah, error) {
+	return []surah.Surah{{ID: 1, NameLatin: "Al-Fatihah"}, {ID: 2, NameLatin: "Al-Baqarah"}}, nil
+}
+
+func (fakeSurahs) GetByID(_ context.Context, id int) (*surah.Surah, error) {
+	if id == 114 {
+		return nil, domain.ErrNotFound
+	}
+	return &surah.Surah{ID: id, NumberOfAyahs: reference.AyahCount(id)}, nil
+}
+
+func (fakeSurahs) GetByRevelationType(_ context.Context, revelationType string) ([]surah.Surah, error) {
+	return []surah.Surah{{ID: 2, RevelationType: revelationType}}, nil
+}
+
+func (fakeSurahs) Search(context.Context, string, int) ([]surah.Match, error) {
+	return nil, nil
+}
+
+type fakeAyahs struct{ rangeCalls *int }
+
+func fakeAyah(id int) ayah.Ayah {
+	surahID, number, _ := reference.Locate(id)
+	return ayah.Ayah{ID: id, SurahID: surahID, NumberInSurah: number, TranslationEn: "en"}
+}
+
+func (fakeAyahs) GetByID(_ context.Context, id int) (*ayah.Ayah, error) {
+	if id == 6236 {
+		return nil, nil
+	}
+	a := fakeAyah(id)
+	return &a, nil
+}
+
+func (fakeAyahs) GetByIDs(_ context.Context, ids []int) ([]ayah.Ayah, error) {
+	var result []ayah.Ayah
+	for _, id := range ids {
+		if id >= 1 && id <= reference.TotalAyahs {
+			result = append(result, fakeAyah(id))
+		}
+	}
+	return result, nil
+}
+
+func (f fakeAyahs) GetByIDRange(_ context.Context, fromID, toID, limit, offset int) ([]ayah.Ayah, error) {
+	*f.rangeCalls++
+	var result []ayah.Ayah
+	for id := fromID + offset; id <= toID && len(result) < limit; id++ {
+		result = append(result, fakeAyah(id))
+	}
+	return result, nil
+}
+
+func (fakeAyahs) GetBySurah(context.Context, int, int, int) ([]ayah.Ayah, error) { return nil, nil }
+func (fakeAyahs) GetBySurahAndNumber(context.Context, int, int) (*ayah.Ayah, error) {
+	return nil, nil
+}
+
+func (fakeAyahs) GetRandom(_ context.Context, surahID int) (*ayah.Ayah, error) {
+	a := fakeAyah(max(1, reference.TotalAyahs/2))
+	if surahID != 0 {
+		id, _ := reference.GlobalID(surahID, 1)
+		a = fakeAyah(id)
+	}
+	return &a, nil
+}
+func (fakeAyahs) GetSajda(context.Context) ([]ayah.SajdaAyah, error) { return nil, nil }
+func (fakeAyahs) Query(context.Context, ayah.Filter) ([]ayah.Ayah, int, error) {
+	return nil, 0, nil
+}
+
+type fakeJuzs struct{}
+
+func (fakeJuzs) GetAll(context.Context) ([]juz.Juz, error) {
+	return []juz.Juz{{JuzNumber: 1, FirstAyahID: 1, LastAyahID: 148, TotalAyahs: 148}}, nil
+}
+
+func (fakeJuzs) GetByNumber(_ context.Context, number int) (*juz.Juz, error) {
+	if number != 1 {
+		return nil, nil
+	}
+	return &juz.Juz{JuzNumber: 1, FirstAyahID: 1, LastAyahID: 148, TotalAyahs: 148}, nil
+}
+
+func (fakeJuzs) GetAyahsByJuz(context.Context, int, int, int) ([]juz.JuzAyah, error) {
+	return nil, nil
+}
+func (fakeJuzs) GetSurahsByJuz(context.Context, int) ([]juz.JuzSurah, error) { return nil, nil }
+
+type fakeSearch struct{ got *search.Params }
+
+func (f fakeSearch) Search(_ context.Context, p search.Params) ([]search.Result, int, error) {
+	*f.got = p
+	return []search.Result{{ID: 262, SurahID: 2, NumberInSurah: 255, SurahInfo: search.SurahInfo{NameLatin: "Al-Baqarah"}}}, 1, nil
+}
+
+type fakeHealth struct{ err error }
+
+func (fakeHealth) HealthCheck(context.Context) (healthcheck.HealthCheck, error) {
+	return healthcheck.HealthCheck{Status: "OK"}, nil
+}
+
+func (f *fakeHealth) ReadyCheck(context.Context) (healthcheck.HealthCheck, error) {
+	return healthcheck.HealthCheck{DBStatus: "OK"}, f.err
+}
+
+type testEnv struct {
+	conn        *grpc.ClientConn
+	client      quranv1.QuranServiceClient
+	health      *fakeHealth
+	rangeCalls  int
+	searchParam search.Params
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	env := &testEnv{health: &fakeHealth{}}
+	srv := grpcserver.New(fakeSurahs{}, fakeAyahs{&env.rangeCalls}, fakeJuzs{}, fakeSearch{&env.searchParam}, env.health)
+
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	env.conn = conn
+	env.client = quranv1.NewQuranServiceClient(conn)
+	return env
+}
+
+func TestQuranService_Unary(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	t.Run("GetAyah by key", func(t *testing.T) {
+		got, err := env.client.GetAyah(ctx, &quranv1.GetAyahRequest{Ayah: &quranv1.GetAyahRequest_Key{Key: "2:255"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.GetId() != 262 || got.GetKey() != "2:255" || got.GetTranslationEn() != "en" {
+			t.Errorf("got %v", got)
+		}
+	})
+
+	t.Run("BatchGetAyahs keeps order", func(t *testing.T) {
+		got, err := env.client.BatchGetAyahs(ctx, &quranv1.BatchGetAyahsRequest{Ids: []int32{262, 1, 9999}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.GetAyahs()) != 2 || got.GetAyahs()[0].GetKey() != "2:255" || got.GetAyahs()[1].GetKey() != "1:1" {
+			t.Errorf("got %v", got.GetAyahs())
+		}
+	})
+
+	t.Run("ListSurahs filtered", func(t *testing.T) {
+		got, err := env.client.ListSurahs(ctx, &quranv1.ListSurahsRequest{RevelationType: "medinan"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.GetSurahs()) != 1 || got.GetSurahs()[0].GetRevelationType() != "medinan" {
+			t.Errorf("got %v", got.GetSurahs())
+		}
+	})
+
+	t.Run("Search defaults", func(t *testing.T) {
+		got, err := env.client.Search(ctx, &quranv1.SearchRequest{Query: " sabar "})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if env.searchParam.Query != "sabar" || env.searchParam.Lang != "id" || env.searchParam.Page != 1 || env.searchParam.Limit != 20 {
+			t.Errorf("params = %+v", env.searchParam)
+		}
+		if got.GetTotal() != 1 || got.GetResults()[0].GetKey() != "2:255" || got.GetResults()[0].GetSurahNameLatin() != "Al-Baqarah" {
+			t.Errorf("got %v", got)
+		}
+	})
+
+	errorTests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{"GetSurah out of range", func() error { _, err := env.client.GetSurah(ctx, &quranv1.GetSurahRequest{Id: 115}); return err }, codes.InvalidArgument},
+		{"GetSurah not found", func() error { _, err := env.client.GetSurah(ctx, &quranv1.GetSurahRequest{Id: 114}); return err }, codes.NotFound},
+		{"GetAyah missing ref", func() error { _, err := env.client.GetAyah(ctx, &quranv1.GetAyahRequest{}); return err }, codes.InvalidArgument},
+		{"GetAyah bad key", func() error {
+			_, err := env.client.GetAyah(ctx, &quranv1.GetAyahRequest{Ayah: &quranv1.GetAyahRequest_Key{Key: "1:8"}})
+			return err
+		}, codes.InvalidArgument},
+		{"GetAyah nil row", func() error {
+			_, err := env.client.GetAyah(ctx, &quranv1.GetAyahRequest{Ayah: &quranv1.GetAyahRequest_Id{Id: 6236}})
+			return err
+		}, codes.NotFound},
+		{"GetJuz not found", func() error { _, err := env.client.GetJuz(ctx, &quranv1.GetJuzRequest{Number: 2}); return err }, codes.NotFound},
+		{"ListSurahs bad type", func() error {
+			_, err := env.client.ListSurahs(ctx, &quranv1.ListSurahsRequest{RevelationType: "makkiyah"})
+			return err
+		}, codes.InvalidArgument},
+		{"Search empty", func() error { _, err := env.client.Search(ctx, &quranv1.SearchRequest{}); return err }, codes.InvalidArgument},
+		{"Search limit", func() error {
+			_, err := env.client.Search(ctx, &quranv1.SearchRequest{Query: "x", Limit: 101})
+			return err
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(tt.call()); got != tt.want {
+				t.Errorf("code = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func collect(t *testing.T, stream grpc.ServerStreamingClient[quranv1.Ayah]) ([]*quranv1.Ayah, error) {
+	t.Helper()
+	var ayahs []*quranv1.Ayah
+	for {
+		a, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return ayahs, nil
+		}
+		if err != nil {
+			return ayahs, err
+		}
+		ayahs = append(ayahs, a)
+	}
+}
+
+func TestQuranService_Streams(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+
+	stream, err := env.client.StreamSurahAyahs(ctx, &quranv1.StreamSurahAyahsRequest{SurahId: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ayahs, err := collect(t, stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ayahs) != 286 || ayahs[0].GetKey() != "2:1" || ayahs[285].GetKey() != "2:286" {
+		t.Errorf("got %d ayahs", len(ayahs))
+	}
+	if env.rangeCalls != 3 {
+		t.Errorf("range calls = %d, want 3 pages of 100", env.rangeCalls)
+	}
+
+	stream, _ = env.client.StreamSurahAyahs(ctx, &quranv1.StreamSurahAyahsRequest{SurahId: 1, From: 6, To: 7})
+	if ayahs, err = collect(t, stream); err != nil || len(ayahs) != 2 || ayahs[0].GetKey() != "1:6" {
+		t.Errorf("partial surah: %v %v", ayahs, err)
+	}
+
+	stream, _ = env.client.StreamSurahAyahs(ctx, &quranv1.StreamSurahAyahsRequest{SurahId: 1, To: 8})
+	if _, err = collect(t, stream); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("out of range: %v", err)
+	}
+
+	juzStream, _ := env.client.StreamJuzAyahs(ctx, &quranv1.StreamJuzAyahsRequest{Number: 1})
+	if ayahs, err = collect(t, juzStream); err != nil || len(ayahs) != 148 {
+		t.Errorf("juz 1: %d ayahs, %v", len(ayahs), err)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.Background()
+	client := healthpb.NewHealthClient(env.conn)
+
+	for _, service := range []string{"", "quran.v1.QuranService"} {
+		got, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil || got.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("Check(%q) = %v, %v", service, got, err)
+		}
+	}
+
+	env.health.err = errors.New("database is locked")
+	if got, _ := client.Check(ctx, &healthpb.HealthCheckRequest{}); got.GetStatus() != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("status with failing ReadyCheck = %v", got.GetStatus())
+	}
+
+	if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: "nope"}); status.Code(err) != codes.NotFound {
+		t.Errorf("unknown service: %v", err)
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	watch, err := client.Watch(watchCtx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := watch.Recv(); err != nil || got.GetStatus() != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("Watch first status = %v, %v", got, err)
+	}
+}
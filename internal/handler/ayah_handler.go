@@ -1,18 +1,26 @@
 package handler
 
 import (
+	"encoding/json"
 	"errors"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
 	"quran-api-go/internal/domain"
 	"quran-api-go/internal/domain/ayah"
 	"quran-api-go/internal/domain/surah"
+	"quran-api-go/pkg/daily"
+	"quran-api-go/pkg/pagination"
+	"quran-api-go/pkg/reference"
 	"quran-api-go/pkg/response"
 	"quran-api-go/pkg/validator"
 )
 
+// maxBatchAyahs caps how many ayahs a single /ayah/batch request may ask for.
+const maxBatchAyahs = 100
+
 type AyahHandler struct {
 	ayahService  ayah.AyahService
 	surahService surah.SurahService
@@ -30,12 +38,14 @@ type SurahSummaryResponse struct {
 }
 
 type AyahListItem struct {
-	Number        int     `json:"number"`
-	NumberInSurah int     `json:"number_in_surah"`
-	TextUthmani   string  `json:"text_uthmani"`
-	Translation   string  `json:"translation"`
-	Juz           int     `json:"juz"`
-	Sajda         *string `json:"sajda"`
+	ID            int               `json:"id"`
+	Number        int               `json:"number"`
+	NumberInSurah int               `json:"number_in_surah"`
+	TextUthmani   string            `json:"text_uthmani"`
+	Translation   string            `json:"translation,omitempty"`
+	Translations  map[string]string `json:"translations,omitempty"`
+	Juz           int               `json:"juz"`
+	Sajda         *string           `json:"sajda"`
 }
 
 type AyahDetailResponse struct {
@@ -44,11 +54,27 @@ type AyahDetailResponse struct {
 	SurahID        int                 `json:"surah_id"`
 	NumberInSurah  int                 `json:"number_in_surah"`
 	TextUthmani    string              `json:"text_uthmani"`
-	Translation    string              `json:"translation"`
+	Translation    string              `json:"translation,omitempty"`
+	Translations   map[string]string   `json:"translations,omitempty"`
 	SurahInfo      AyahDetailSurahInfo `json:"surah_info"`
 	Juz            int                 `json:"juz"`
 	Sajda          *string             `json:"sajda"`
 	RevelationType *string             `json:"revelation_type"`
+	Prev           *AyahRef            `json:"prev"` // null for the first ayah of the mushaf
+	Next           *AyahRef            `json:"next"` // null for the last ayah of the mushaf
+}
+
+// AyahRef points at another ayah by global ID and surah:ayah key.
+type AyahRef struct {
+	ID  int    `json:"id" example:"261"`
+	Key string `json:"key" example:"2:254"`
+}
+
+// AyahContextResponse is an ayah with the ayahs around it in mushaf order.
+type AyahContextResponse struct {
+	Ayah   AyahDetailResponse `json:"ayah"`
+	Before []AyahRangeAyah    `json:"before"`
+	After  []AyahRangeAyah    `json:"after"`
 }
 
 type AyahDetailSurahInfo struct {
@@ -57,14 +83,95 @@ type AyahDetailSurahInfo struct {
 }
 
 type SajdaListItem struct {
-	ID            int    `json:"id"`
-	SurahID       int    `json:"surah_id"`
-	SurahName     string `json:"surah_name"`
-	NumberInSurah int    `json:"number_in_surah"`
-	TextUthmani   string `json:"text_uthmani"`
-	Translation   string `json:"translation"`
-	Juz           int    `json:"juz"`
-	SajdaType     string `json:"sajda_type"`
+	ID            int               `json:"id"`
+	SurahID       int               `json:"surah_id"`
+	SurahName     string            `json:"surah_name"`
+	NumberInSurah int               `json:"number_in_surah"`
+	TextUthmani   string            `json:"text_uthmani"`
+	Translation   string            `json:"translation,omitempty"`
+	Translations  map[string]string `json:"translations,omitempty"`
+	Juz           int               `json:"juz"`
+	SajdaType     string            `json:"sajda_type"`
+}
+
+// AyahRangeResponse is one page of a cross-surah range in mushaf order.
+type AyahRangeResponse struct {
+	From  string          `json:"from"`
+	To    string          `json:"to"`
+	Total int             `json:"total"`
+	Page  int             `json:"page"`
+	Limit int             `json:"limit"`
+	Items []AyahRangeItem `json:"items"`
+}
+
+// AyahListResponse is one page of the ayahs matching the /ayah filters. The
+// pagination is in the meta block of the response.
+type AyahListResponse struct {
+	Ayahs []AyahDetailResponse `json:"ayahs"`
+}
+
+// AyahRangeItem is either a surah header (type "surah") or an ayah
+// (type "ayah"). Headers precede the first ayah of each surah and open every
+// page, so a page can be rendered without the previous one.
+type AyahRangeItem struct {
+	Type  string                `json:"type" enums:"surah,ayah"`
+	Surah *SurahSummaryResponse `json:"surah,omitempty"`
+	Ayah  *AyahRangeAyah        `json:"ayah,omitempty"`
+}
+
+type AyahRangeAyah struct {
+	ID            int               `json:"id"`
+	SurahID       int               `json:"surah_id"`
+	NumberInSurah int               `json:"number_in_surah"`
+	TextUthmani   string            `json:"text_uthmani"`
+	Translation   string            `json:"translation,omitempty"`
+	Translations  map[string]string `json:"translations,omitempty"`
+	Juz           int               `json:"juz"`
+	Sajda         *string           `json:"sajda"`
+}
+
+// AyahBatchRequest lists the ayahs to fetch in the order they should be
+// returned. Each entry is a global ID (262) or a surah:ayah key ("2:255").
+type AyahBatchRequest struct {
+	IDs []AyahKey `json:"ids" swaggertype:"array,string" example:"262,2:255"`
+}
+
+// AyahKey is a global ayah ID or a "surah:ayah" key. It accepts both JSON
+// numbers and strings.
+type AyahKey string
+
+func (k *AyahKey) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*k = AyahKey(s)
+		return nil
+	}
+	*k = AyahKey(b)
+	return nil
+}
+
+// GlobalID resolves the key to a global ayah ID (1-6236).
+func (k AyahKey) GlobalID() (int, bool) {
+	raw := strings.TrimSpace(string(k))
+	if surahPart, numberPart, ok := strings.Cut(raw, ":"); ok {
+		surahID, err := strconv.Atoi(strings.TrimSpace(surahPart))
+		if err != nil {
+			return 0, false
+		}
+		number, err := strconv.Atoi(strings.TrimSpace(numberPart))
+		if err != nil {
+			return 0, false
+		}
+		return reference.GlobalID(surahID, number)
+	}
+	id, err := strconv.Atoi(raw)
+	if err != nil || id < 1 || id > reference.TotalAyahs {
+		return 0, false
+	}
+	return id, true
 }
 
 func NewAyahHandler(ayahService ayah.AyahService, surahService surah.SurahService) *AyahHandler {
@@ -75,52 +182,77 @@ func NewAyahHandler(ayahService ayah.AyahService, surahService surah.SurahServic
 // @Summary     Get ayahs by surah
 // @Description Get ayahs from a specific surah with optional range filtering
 // @Tags        Ayah
-// @Produce     json
-// @Param       id    path     int     true   "Surah ID (1-114)"  minimum(1)  maximum(114)
+// @Produce     json,text/csv,application/x-ndjson,text/markdown,text/plain
+// @Param       id    path     string  true   "Surah ID (1-114) or name, e.g. 36 or yasin"
 // @Param       from  query    int     false  "Start ayah number (must use with 'to')"
 // @Param       to    query    int     false  "End ayah number (must use with 'from')"
-// @Param       lang  query    string  false  "Translation language"  Enums(id, en)  default(id)
+// @Param       lang  query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
+// @Param       format   query    string  false  "Output format (overrides Accept)"  Enums(json, csv, ndjson, markdown, text)
 // @Success     200   {object} response.SuccessResponse{data=SurahAyahsResponse}
 // @Failure     400   {object} response.ErrorResponse
 // @Failure     404   {object} response.ErrorResponse
 // @Failure     500   {object} response.ErrorResponse
 // @Router      /surah/{id}/ayah [get]
 func (h *AyahHandler) BySurah(c *gin.Context) {
-	surahIDParam, err := validator.ValidateIDParam(c.Param("id"))
-	if err != nil {
-		response.BadRequest(c, "invalid surah id")
+	var p struct {
+		surahPath
+		From int `query:"from" validate:"min=1,requires=to" code:"INVALID_AYAH_RANGE"`
+		To   int `query:"to" validate:"min=1,requires=from,gtefield=from" code:"INVALID_AYAH_RANGE"`
+	}
+	if !bind(c, &p) {
 		return
 	}
-	surahID, _ := strconv.Atoi(surahIDParam)
-	lang, err := validator.ValidateLang(c.Query("lang"))
+	langs, err := requestLangs(c)
 	if err != nil {
-		response.BadRequest(c, "lang must be 'id' or 'en'")
+		badLang(c)
 		return
 	}
-	sur, err := h.surahService.GetByID(c.Request.Context(), surahID)
+	sur, err := h.surahService.GetByID(c.Request.Context(), int(p.ID))
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			response.NotFound(c, "surah not found")
+			response.NotFound(c, domain.CodeSurahNotFound, "surah not found")
 			return
 		}
 		response.InternalError(c)
 		return
 	}
 	if sur == nil {
-		response.NotFound(c, "surah not found")
+		response.NotFound(c, domain.CodeSurahNotFound, "surah not found")
 		return
 	}
-	from, to, err := parseAyahRange(c.Query("from"), c.Query("to"), sur.NumberOfAyahs)
-	if err != nil {
-		response.BadRequest(c, "invalid ayah range")
-		return
+	from, to := 1, sur.NumberOfAyahs
+	if p.From != 0 {
+		if p.To > sur.NumberOfAyahs {
+			badRequest(c, validator.RangeError("to", domain.CodeInvalidAyahRange, p.From, sur.NumberOfAyahs))
+			return
+		}
+		from, to = p.From, p.To
 	}
-	ayahs, err := h.ayahService.GetBySurah(c.Request.Context(), surahID, from, to)
+	ayahs, err := h.ayahService.GetBySurah(c.Request.Context(), int(p.ID), from, to)
 	if err != nil {
 		response.InternalError(c)
 		return
 	}
-	response.Success(c, newSurahAyahsResponse(*sur, ayahs, lang))
+	result := newSurahAyahsResponse(*sur, ayahs, langs)
+	respondList(c, result, func() response.Table {
+		rows := make([]ayahRow, 0, len(result.Ayahs))
+		for _, item := range result.Ayahs {
+			rows = append(rows, ayahRow{
+				ID:            item.Number,
+				SurahID:       sur.ID,
+				SurahName:     sur.NameLatin,
+				NumberInSurah: item.NumberInSurah,
+				TextUthmani:   item.TextUthmani,
+				Translation:   item.Translation,
+				Translations:  item.Translations,
+				Juz:           item.Juz,
+				Item:          item,
+			})
+		}
+		return newAyahTable(langs, rows)
+	}, nil, h.surahService)
 }
 
 // Detail godoc
@@ -129,37 +261,254 @@ func (h *AyahHandler) BySurah(c *gin.Context) {
 // @Tags        Ayah
 // @Produce     json
 // @Param       id    path     int     true   "Global ayah ID (1-6236)"  minimum(1)  maximum(6236)
-// @Param       lang  query    string  false  "Translation language"  Enums(id, en)  default(id)
+// @Param       lang  query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
 // @Success     200   {object} response.SuccessResponse{data=AyahDetailResponse}
 // @Failure     400   {object} response.ErrorResponse
 // @Failure     404   {object} response.ErrorResponse
 // @Failure     500   {object} response.ErrorResponse
 // @Router      /ayah/{id} [get]
 func (h *AyahHandler) Detail(c *gin.Context) {
-	ayahID, err := parseIDParam(c.Param("id"))
-	if err != nil {
-		response.BadRequest(c, "invalid ayah id")
+	var p struct {
+		ID int `path:"id" validate:"required,min=1,max=6236" code:"INVALID_AYAH_ID"`
+	}
+	if !bind(c, &p) {
 		return
 	}
-	lang, err := validator.ValidateLang(c.Query("lang"))
+	langs, err := requestLangs(c)
 	if err != nil {
-		response.BadRequest(c, "lang must be 'id' or 'en'")
+		badLang(c)
 		return
 	}
-	ay, err := h.ayahService.GetByID(c.Request.Context(), ayahID)
+	ay, err := h.ayahService.GetByID(c.Request.Context(), p.ID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			response.NotFound(c, "ayah not found")
+			response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
 			return
 		}
 		response.InternalError(c)
 		return
 	}
 	if ay == nil {
-		response.NotFound(c, "ayah not found")
+		response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
+		return
+	}
+	h.respondWithAyahDetail(c, *ay, langs)
+}
+
+// Batch godoc
+// @Summary     Get ayahs in batch
+// @Description Get up to 100 ayahs in one request by global ID or surah:ayah key. Ayahs are returned in request order, one entry per requested id; an ayah missing from the dataset is null.
+// @Tags        Ayah
+// @Accept      json
+// @Produce     json
+// @Param       body  body     AyahBatchRequest  true   "Ayah IDs or surah:ayah keys"
+// @Param       lang  query    string            false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
+// @Success     200   {object} response.SuccessResponse{data=[]AyahDetailResponse}
+// @Failure     400   {object} response.ErrorResponse
+// @Failure     500   {object} response.ErrorResponse
+// @Router      /ayah/batch [post]
+func (h *AyahHandler) Batch(c *gin.Context) {
+	langs, err := requestLangs(c)
+	if err != nil {
+		badLang(c)
+		return
+	}
+	var req AyahBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, domain.CodeInvalidRequestBody, "invalid request body")
 		return
 	}
-	h.respondWithAyahDetail(c, *ay, lang)
+	if len(req.IDs) == 0 {
+		response.BadRequest(c, domain.CodeInvalidParam, "ids must not be empty", response.Param("ids"))
+		return
+	}
+	if len(req.IDs) > maxBatchAyahs {
+		response.BadRequestf(c, domain.CodeTooManyIDs, response.Details{"param": "ids", "max": maxBatchAyahs}, "ids must contain at most %d entries", maxBatchAyahs)
+		return
+	}
+	ids := make([]int, len(req.IDs))
+	for i, key := range req.IDs {
+		id, ok := key.GlobalID()
+		if !ok {
+			response.BadRequestf(c, domain.CodeInvalidAyahID, response.Details{"param": "ids", "value": string(key)}, "invalid ayah id or key %q", string(key))
+			return
+		}
+		ids[i] = id
+	}
+
+	ayahs, err := h.ayahService.GetByIDs(c.Request.Context(), ids)
+	if err != nil {
+		response.InternalError(c)
+		return
+	}
+	// One surah query for the whole batch instead of one per ayah.
+	surahs, err := h.surahService.GetAll(c.Request.Context())
+	if err != nil {
+		response.InternalError(c)
+		return
+	}
+	surahByID := make(map[int]surah.Surah, len(surahs))
+	for _, s := range surahs {
+		surahByID[s.ID] = s
+	}
+
+	byID := make(map[int]ayah.Ayah, len(ayahs))
+	for _, ay := range ayahs {
+		byID[ay.ID] = ay
+	}
+
+	// One entry per requested id, null where the dataset lacks the ayah, so
+	// results line up with the request.
+	result := make([]*AyahDetailResponse, len(ids))
+	for i, id := range ids {
+		if ay, ok := byID[id]; ok {
+			detail := newAyahDetailResponse(ay, surahByID[ay.SurahID], langs)
+			result[i] = &detail
+		}
+	}
+	respond(c, result, h.surahService)
+}
+
+// Range godoc
+// @Summary     Get ayahs across surahs
+// @Description Get every ayah between two references in mushaf order, crossing surah boundaries. A surah header item is inserted where each surah starts.
+// @Tags        Ayah
+// @Produce     json
+// @Param       from   query    string  true   "Start ayah as surah:ayah key or global ID"  example(2:250)
+// @Param       to     query    string  true   "End ayah as surah:ayah key or global ID"    example(3:10)
+// @Param       page   query    int     false  "Page number"  minimum(1)  default(1)
+// @Param       limit  query    int     false  "Ayahs per page"  minimum(1)  maximum(100)  default(20)
+// @Param       lang   query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
+// @Success     200    {object} response.PageResponse{data=AyahRangeResponse}
+// @Header      200    {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Failure     400    {object} response.ErrorResponse
+// @Failure     500    {object} response.ErrorResponse
+// @Router      /range [get]
+func (h *AyahHandler) Range(c *gin.Context) {
+	var p struct {
+		From ayahID `query:"from" validate:"required,min=1,max=6236" code:"INVALID_AYAH_ID"`
+		To   ayahID `query:"to" validate:"required,min=1,max=6236,gtefield=from" code:"INVALID_AYAH_RANGE"`
+		pageQuery
+	}
+	if !bind(c, &p) {
+		return
+	}
+	langs, err := requestLangs(c)
+	if err != nil {
+		badLang(c)
+		return
+	}
+	fromID, toID := int(p.From), int(p.To)
+	params := p.params()
+
+	ayahs, err := h.ayahService.GetByIDRange(c.Request.Context(), fromID, toID, params.Limit, params.Offset)
+	if err != nil {
+		response.InternalError(c)
+		return
+	}
+	surahs, err := h.surahService.GetAll(c.Request.Context())
+	if err != nil {
+		response.InternalError(c)
+		return
+	}
+	surahByID := make(map[int]surah.Surah, len(surahs))
+	for _, s := range surahs {
+		surahByID[s.ID] = s
+	}
+
+	total := toID - fromID + 1
+	respondPage(c, AyahRangeResponse{
+		From:  c.Query("from"),
+		To:    c.Query("to"),
+		Total: total,
+		Page:  params.Page,
+		Limit: params.Limit,
+		Items: newAyahRangeItems(ayahs, surahByID, langs),
+	}, pagination.NewMeta(params, total), h.surahService)
+}
+
+// Context godoc
+// @Summary     Get an ayah with surrounding ayahs
+// @Description Get an ayah with up to 10 ayahs before and after it in mushaf order. The window stops at the surah boundary unless cross_surah is true. The ayah carries prev and next references for navigation.
+// @Tags        Ayah
+// @Produce     json
+// @Param       id           path     string  true   "Global ayah ID (1-6236) or surah:ayah key"
+// @Param       before       query    int     false  "Ayahs before"  minimum(0)  maximum(10)  default(2)
+// @Param       after        query    int     false  "Ayahs after"   minimum(0)  maximum(10)  default(2)
+// @Param       cross_surah  query    bool    false  "Continue into the previous and next surah"  default(false)
+// @Param       lang         query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
+// @Success     200          {object} response.SuccessResponse{data=AyahContextResponse}
+// @Failure     400          {object} response.ErrorResponse
+// @Failure     404          {object} response.ErrorResponse
+// @Failure     500          {object} response.ErrorResponse
+// @Router      /ayah/{id}/context [get]
+func (h *AyahHandler) Context(c *gin.Context) {
+	var p struct {
+		ayahPath
+		// before and after are capped at 10 ayahs each.
+		Before     int  `query:"before" default:"2" validate:"min=0,max=10"`
+		After      int  `query:"after" default:"2" validate:"min=0,max=10"`
+		CrossSurah bool `query:"cross_surah" default:"false"`
+	}
+	if !bind(c, &p) {
+		return
+	}
+	ayahID, before, after, crossSurah := int(p.ID), p.Before, p.After, p.CrossSurah
+	langs, err := requestLangs(c)
+	if err != nil {
+		badLang(c)
+		return
+	}
+
+	fromID, toID := max(1, ayahID-before), min(reference.TotalAyahs, ayahID+after)
+	if !crossSurah {
+		surahID, number, _ := reference.Locate(ayahID)
+		fromID = max(fromID, ayahID-number+1)
+		toID = min(toID, ayahID+reference.AyahCount(surahID)-number)
+	}
+	ayahs, err := h.ayahService.GetByIDRange(c.Request.Context(), fromID, toID, toID-fromID+1, 0)
+	if err != nil {
+		response.InternalError(c)
+		return
+	}
+
+	var result AyahContextResponse
+	found := false
+	for _, item := range ayahs {
+		switch {
+		case item.ID < ayahID:
+			result.Before = append(result.Before, newAyahRangeAyah(item, langs))
+		case item.ID > ayahID:
+			result.After = append(result.After, newAyahRangeAyah(item, langs))
+		default:
+			sur, err := h.surahService.GetByID(c.Request.Context(), item.SurahID)
+			if err != nil || sur == nil {
+				response.InternalError(c)
+				return
+			}
+			result.Ayah = newAyahDetailResponse(item, *sur, langs)
+			found = true
+		}
+	}
+	if !found {
+		response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
+		return
+	}
+	if result.Before == nil {
+		result.Before = []AyahRangeAyah{}
+	}
+	if result.After == nil {
+		result.After = []AyahRangeAyah{}
+	}
+	respond(c, result, h.surahService)
 }
 
 // BySurahAndNumber godoc
@@ -167,99 +516,212 @@ func (h *AyahHandler) Detail(c *gin.Context) {
 // @Description Get a specific ayah by its surah ID and number within that surah
 // @Tags        Ayah
 // @Produce     json
-// @Param       id      path     int     true   "Surah ID (1-114)"  minimum(1)  maximum(114)
+// @Param       id      path     string  true   "Surah ID (1-114) or name, e.g. 36 or yasin"
 // @Param       number  path     int     true   "Ayah number within the surah"  minimum(1)
-// @Param       lang    query    string  false  "Translation language"  Enums(id, en)  default(id)
+// @Param       lang    query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
 // @Success     200     {object} response.SuccessResponse{data=AyahDetailResponse}
 // @Failure     400     {object} response.ErrorResponse
 // @Failure     404     {object} response.ErrorResponse
 // @Failure     500     {object} response.ErrorResponse
 // @Router      /surah/{id}/ayah/{number} [get]
 func (h *AyahHandler) BySurahAndNumber(c *gin.Context) {
-	surahID, err := parseIDParam(c.Param("id"))
-	if err != nil {
-		response.BadRequest(c, "invalid surah id")
+	var p struct {
+		surahPath
+		Number int `path:"number" validate:"required,min=1" code:"INVALID_AYAH_NUMBER"`
+	}
+	if !bind(c, &p) {
 		return
 	}
-	number, err := parseIDParam(c.Param("number"))
-	if err != nil {
-		response.BadRequest(c, "invalid ayah number")
+	surahID, number := int(p.ID), p.Number
+	if count := reference.AyahCount(surahID); number > count {
+		badRequest(c, validator.RangeError("number", domain.CodeInvalidAyahNumber, 1, count))
 		return
 	}
-	lang, err := validator.ValidateLang(c.Query("lang"))
+	langs, err := requestLangs(c)
 	if err != nil {
-		response.BadRequest(c, "lang must be 'id' or 'en'")
+		badLang(c)
 		return
 	}
 	ay, err := h.ayahService.GetBySurahAndNumber(c.Request.Context(), surahID, number)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			response.NotFound(c, "ayah not found")
+			response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
 			return
 		}
 		response.InternalError(c)
 		return
 	}
 	if ay == nil {
-		response.NotFound(c, "ayah not found")
+		response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
 		return
 	}
-	h.respondWithAyahDetail(c, *ay, lang)
+	h.respondWithAyahDetail(c, *ay, langs)
 }
 
 // RandomAyah godoc
 // @Summary     Get random ayah
-// @Description Get a random ayah, optionally filtered by surah
+// @Description Get a random ayah, optionally filtered by surah. With seed the pick is reproducible: the same seed (and surah_id) always returns the same ayah.
 // @Tags        Ayah
 // @Produce     json
-// @Param       surah_id  query    int     false  "Filter by surah ID (0 = any)"  minimum(0)  default(0)
-// @Param       lang      query    string  false  "Translation language"  Enums(id, en)  default(id)
+// @Param       surah_id  query    int     false  "Filter by surah ID (0 = any)"  minimum(0)  maximum(114)  default(0)
+// @Param       seed      query    string  false  "Seed for a reproducible pick"
+// @Param       lang      query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
 // @Success     200       {object} response.SuccessResponse{data=AyahDetailResponse}
 // @Failure     400       {object} response.ErrorResponse
 // @Failure     404       {object} response.ErrorResponse
 // @Failure     500       {object} response.ErrorResponse
 // @Router      /random [get]
 func (h *AyahHandler) RandomAyah(c *gin.Context) {
-	surahIDParam := c.DefaultQuery("surah_id", "0")
-	surahID, err := strconv.Atoi(surahIDParam)
-	if err != nil {
-		surahID = 0
+	var q struct {
+		SurahID int    `query:"surah_id" default:"0" validate:"min=0,max=114" code:"INVALID_SURAH_ID"`
+		Seed    string `query:"seed"`
+	}
+	if !bind(c, &q) {
+		return
 	}
-	lang, err := validator.ValidateLang(c.Query("lang"))
+	langs, err := requestLangs(c)
 	if err != nil {
-		response.BadRequest(c, "lang must be 'id' or 'en'")
+		badLang(c)
 		return
 	}
-	ay, err := h.ayahService.GetRandom(c.Request.Context(), surahID)
+	var ay *ayah.Ayah
+	if q.Seed != "" {
+		pool := daily.Mushaf()
+		if q.SurahID != 0 {
+			pool = daily.Surah(q.SurahID)
+		}
+		ay, err = h.ayahService.GetByID(c.Request.Context(), pool.Pick("random/"+q.Seed))
+	} else {
+		ay, err = h.ayahService.GetRandom(c.Request.Context(), q.SurahID)
+	}
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			response.NotFound(c, "ayah not found")
+			response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
 			return
 		}
 		response.InternalError(c)
 		return
 	}
 	if ay == nil {
-		response.NotFound(c, "ayah not found")
+		response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
 		return
 	}
-	h.respondWithAyahDetail(c, *ay, lang)
+	h.respondWithAyahDetail(c, *ay, langs)
+}
+
+// List godoc
+// @Summary     Query ayahs
+// @Description Ayahs matching every given filter, e.g. the Meccan ayahs of juz 30 (juz=30&revelation=meccan). Without filters it pages through the whole mushaf. sort orders by a field, descending with a leading "-"; ties keep mushaf order.
+// @Tags        Ayah
+// @Produce     json,text/csv,application/x-ndjson,text/markdown,text/plain
+// @Param       surah       query    string  false  "Surah number (1-114) or name"
+// @Param       juz         query    int     false  "Juz number"  minimum(1)  maximum(30)
+// @Param       revelation  query    string  false  "Revelation type"  Enums(meccan, medinan)
+// @Param       sajda       query    bool    false  "Only sajda ayahs (true) or none of them (false)"
+// @Param       min_words   query    int     false  "Minimum number of words"  minimum(1)
+// @Param       sort        query    string  false  "Sort order"  Enums(id, -id, words, -words, letters, -letters)  default(id)
+// @Param       page        query    int     false  "Page number"  minimum(1)  default(1)
+// @Param       limit       query    int     false  "Ayahs per page"  minimum(1)  maximum(100)  default(20)
+// @Param       lang        query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields      query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include     query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
+// @Param       format      query    string  false  "Output format (overrides Accept)"  Enums(json, csv, ndjson, markdown, text)
+// @Success     200         {object} response.PageResponse{data=AyahListResponse}
+// @Header      200         {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Failure     400         {object} response.ErrorResponse
+// @Failure     500         {object} response.ErrorResponse
+// @Router      /ayah [get]
+func (h *AyahHandler) List(c *gin.Context) {
+	var q struct {
+		Surah      surahID      `query:"surah" validate:"min=1,max=114" code:"INVALID_SURAH_ID"`
+		Juz        int          `query:"juz" validate:"min=1,max=30" code:"INVALID_JUZ_NUMBER"`
+		Revelation string       `query:"revelation" validate:"oneof=meccan medinan" code:"INVALID_REVELATION_TYPE"`
+		Sajda      optionalBool `query:"sajda"`
+		MinWords   int          `query:"min_words" validate:"min=1"`
+		Sort       string       `query:"sort" default:"id" validate:"oneof=id -id words -words letters -letters"`
+		pageQuery
+	}
+	if !bind(c, &q) {
+		return
+	}
+	langs, err := requestLangs(c)
+	if err != nil {
+		badLang(c)
+		return
+	}
+	params := q.params()
+
+	ayahs, total, err := h.ayahService.Query(c.Request.Context(), ayah.Filter{
+		SurahID:    int(q.Surah),
+		Juz:        q.Juz,
+		Revelation: q.Revelation,
+		Sajda:      q.Sajda.ptr(),
+		MinWords:   q.MinWords,
+		Sort:       ayah.Sort(q.Sort),
+		Limit:      params.Limit,
+		Offset:     params.Offset,
+	})
+	if err != nil {
+		response.InternalError(c)
+		return
+	}
+	surahs, err := h.surahService.GetAll(c.Request.Context())
+	if err != nil {
+		response.InternalError(c)
+		return
+	}
+	surahByID := make(map[int]surah.Surah, len(surahs))
+	for _, s := range surahs {
+		surahByID[s.ID] = s
+	}
+
+	result := AyahListResponse{
+		Ayahs: make([]AyahDetailResponse, 0, len(ayahs)),
+	}
+	for _, ay := range ayahs {
+		result.Ayahs = append(result.Ayahs, newAyahDetailResponse(ay, surahByID[ay.SurahID], langs))
+	}
+	meta := pagination.NewMeta(params, total)
+	respondList(c, result, func() response.Table {
+		rows := make([]ayahRow, 0, len(result.Ayahs))
+		for _, item := range result.Ayahs {
+			rows = append(rows, ayahRow{
+				ID:            item.ID,
+				SurahID:       item.SurahID,
+				SurahName:     item.SurahInfo.NameLatin,
+				NumberInSurah: item.NumberInSurah,
+				TextUthmani:   item.TextUthmani,
+				Translation:   item.Translation,
+				Translations:  item.Translations,
+				Juz:           item.Juz,
+				Item:          item,
+			})
+		}
+		return newAyahTable(langs, rows)
+	}, &meta, h.surahService)
 }
 
 // Sajda godoc
 // @Summary     List sajda ayahs
 // @Description Get all 15 sajda tilawah ayahs in the Quran
 // @Tags        Ayah
-// @Produce     json
-// @Param       lang  query    string  false  "Translation language"  Enums(id, en)  default(id)
+// @Produce     json,text/csv,application/x-ndjson,text/markdown,text/plain
+// @Param       lang  query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
+// @Param       format   query    string  false  "Output format (overrides Accept)"  Enums(json, csv, ndjson, markdown, text)
 // @Success     200   {object} response.SuccessResponse{data=[]SajdaListItem}
 // @Failure     400   {object} response.ErrorResponse
 // @Failure     500   {object} response.ErrorResponse
 // @Router      /sajda [get]
 func (h *AyahHandler) Sajda(c *gin.Context) {
-	lang, err := validator.ValidateLang(c.Query("lang"))
+	langs, err := requestLangs(c)
 	if err != nil {
-		response.BadRequest(c, "lang must be 'id' or 'en'")
+		badLang(c)
 		return
 	}
 	ayahs, err := h.ayahService.GetSajda(c.Request.Context())
@@ -269,10 +731,7 @@ func (h *AyahHandler) Sajda(c *gin.Context) {
 	}
 	result := make([]SajdaListItem, 0, len(ayahs))
 	for _, a := range ayahs {
-		translation := a.TranslationIdo
-		if lang == "en" {
-			translation = a.TranslationEn
-		}
+		translation, translations := translationFor(langs, a.TranslationIdo, a.TranslationEn)
 		result = append(result, SajdaListItem{
 			ID:            a.AyahID,
 			SurahID:       a.SurahID,
@@ -280,36 +739,46 @@ func (h *AyahHandler) Sajda(c *gin.Context) {
 			NumberInSurah: a.NumberInSurah,
 			TextUthmani:   a.TextUthmani,
 			Translation:   translation,
+			Translations:  translations,
 			Juz:           a.JuzNumber,
 			SajdaType:     a.SajdaType,
 		})
 	}
-	response.Success(c, result)
-}
-
-func parseAyahRange(fromParam, toParam string, maxAyahs int) (int, int, error) {
-	if fromParam == "" && toParam == "" {
-		return 1, maxAyahs, nil
-	}
-	if fromParam == "" || toParam == "" {
-		return 0, 0, domain.ErrInvalidRangeParam
-	}
-	if err := validator.ValidateRangeParam(fromParam, toParam); err != nil {
-		return 0, 0, err
-	}
-	from, _ := strconv.Atoi(fromParam)
-	to, _ := strconv.Atoi(toParam)
-	return from, to, nil
+	respondList(c, result, func() response.Table {
+		rows := make([]ayahRow, 0, len(result))
+		for _, item := range result {
+			rows = append(rows, ayahRow{
+				ID:            item.ID,
+				SurahID:       item.SurahID,
+				SurahName:     item.SurahName,
+				NumberInSurah: item.NumberInSurah,
+				TextUthmani:   item.TextUthmani,
+				Translation:   item.Translation,
+				Translations:  item.Translations,
+				Juz:           item.Juz,
+				Item:          item,
+			})
+		}
+		table := newAyahTable(langs, rows)
+		table.Columns = append(table.Columns, "sajda_type")
+		for i := range table.Rows {
+			table.Rows[i].Values = append(table.Rows[i].Values, result[i].SajdaType)
+		}
+		return table
+	}, nil, h.surahService)
 }
 
-func newSurahAyahsResponse(sur surah.Surah, ayahs []ayah.Ayah, lang string) SurahAyahsResponse {
+func newSurahAyahsResponse(sur surah.Surah, ayahs []ayah.Ayah, langs []string) SurahAyahsResponse {
 	responseAyahs := make([]AyahListItem, 0, len(ayahs))
 	for _, item := range ayahs {
+		translation, translations := translationByLang(item, langs)
 		responseAyahs = append(responseAyahs, AyahListItem{
+			ID:            item.ID,
 			Number:        item.ID,
 			NumberInSurah: item.NumberInSurah,
 			TextUthmani:   item.TextUthmani,
-			Translation:   translationByLang(item, lang),
+			Translation:   translation,
+			Translations:  translations,
 			Juz:           item.JuzNumber,
 			Sajda:         item.SajdaType,
 		})
@@ -320,49 +789,89 @@ func newSurahAyahsResponse(sur surah.Surah, ayahs []ayah.Ayah, lang string) Sura
 	}
 }
 
-func translationByLang(item ayah.Ayah, lang string) string {
-	if lang == "en" {
-		return item.TranslationEn
+func newAyahRangeAyah(item ayah.Ayah, langs []string) AyahRangeAyah {
+	translation, translations := translationByLang(item, langs)
+	return AyahRangeAyah{
+		ID:            item.ID,
+		SurahID:       item.SurahID,
+		NumberInSurah: item.NumberInSurah,
+		TextUthmani:   item.TextUthmani,
+		Translation:   translation,
+		Translations:  translations,
+		Juz:           item.JuzNumber,
+		Sajda:         item.SajdaType,
+	}
+}
+
+func newAyahRangeItems(ayahs []ayah.Ayah, surahByID map[int]surah.Surah, langs []string) []AyahRangeItem {
+	items := make([]AyahRangeItem, 0, len(ayahs)+1)
+	for i, item := range ayahs {
+		if i == 0 || item.NumberInSurah == 1 {
+			sur := surahByID[item.SurahID]
+			items = append(items, AyahRangeItem{
+				Type:  "surah",
+				Surah: &SurahSummaryResponse{ID: sur.ID, Number: sur.Number, NameLatin: sur.NameLatin},
+			})
+		}
+		rangeAyah := newAyahRangeAyah(item, langs)
+		items = append(items, AyahRangeItem{Type: "ayah", Ayah: &rangeAyah})
+	}
+	return items
+}
+
+func translationByLang(item ayah.Ayah, langs []string) (string, map[string]string) {
+	return translationFor(langs, item.TranslationIdo, item.TranslationEn)
+}
+
+func (h *AyahHandler) respondWithAyahDetail(c *gin.Context, ay ayah.Ayah, langs []string) {
+	if detail, ok := loadAyahDetail(c, h.surahService, ay, langs); ok {
+		respond(c, detail, h.surahService)
 	}
-	return item.TranslationIdo
 }
 
-func (h *AyahHandler) respondWithAyahDetail(c *gin.Context, ay ayah.Ayah, lang string) {
-	sur, err := h.surahService.GetByID(c.Request.Context(), ay.SurahID)
+// loadAyahDetail builds the detail response for ay, fetching its surah. On
+// failure it writes the error response and reports false.
+func loadAyahDetail(c *gin.Context, surahs surah.SurahService, ay ayah.Ayah, langs []string) (AyahDetailResponse, bool) {
+	sur, err := surahs.GetByID(c.Request.Context(), ay.SurahID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			response.NotFound(c, "surah not found")
-			return
+			response.NotFound(c, domain.CodeSurahNotFound, "surah not found")
+			return AyahDetailResponse{}, false
 		}
 		response.InternalError(c)
-		return
+		return AyahDetailResponse{}, false
 	}
 	if sur == nil {
-		response.NotFound(c, "surah not found")
-		return
+		response.NotFound(c, domain.CodeSurahNotFound, "surah not found")
+		return AyahDetailResponse{}, false
 	}
-	response.Success(c, newAyahDetailResponse(ay, *sur, lang))
+	return newAyahDetailResponse(ay, *sur, langs), true
 }
 
-func newAyahDetailResponse(item ayah.Ayah, sur surah.Surah, lang string) AyahDetailResponse {
+func newAyahDetailResponse(item ayah.Ayah, sur surah.Surah, langs []string) AyahDetailResponse {
+	translation, translations := translationByLang(item, langs)
 	return AyahDetailResponse{
 		ID:             item.ID,
 		Number:         item.ID,
 		SurahID:        item.SurahID,
 		NumberInSurah:  item.NumberInSurah,
 		TextUthmani:    item.TextUthmani,
-		Translation:    translationByLang(item, lang),
+		Translation:    translation,
+		Translations:   translations,
 		SurahInfo:      AyahDetailSurahInfo{ID: sur.ID, NameLatin: sur.NameLatin},
 		Juz:            item.JuzNumber,
 		Sajda:          item.SajdaType,
 		RevelationType: item.RevelationType,
+		Prev:           newAyahRef(item.ID - 1),
+		Next:           newAyahRef(item.ID + 1),
 	}
 }
 
-func parseIDParam(raw string) (int, error) {
-	validated, err := validator.ValidateIDParam(raw)
-	if err != nil {
-		return 0, err
+// newAyahRef returns nil for IDs outside the mushaf.
+func newAyahRef(id int) *AyahRef {
+	surahID, number, ok := reference.Locate(id)
+	if !ok {
+		return nil
 	}
-	return strconv.Atoi(validated)
+	return &AyahRef{ID: id, Key: strconv.Itoa(surahID) + ":" + strconv.Itoa(number)}
 }
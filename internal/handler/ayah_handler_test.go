@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -14,6 +15,8 @@ import (
 	"quran-api-go/internal/domain/ayah"
 	"quran-api-go/internal/domain/surah"
 	"quran-api-go/internal/handler"
+	"quran-api-go/internal/middleware"
+	"quran-api-go/pkg/reference"
 )
 
 const (
@@ -23,9 +26,13 @@ const (
 
 type MockAyahService struct {
 	GetByIDFunc             func(ctx context.Context, id int) (*ayah.Ayah, error)
+	GetByIDsFunc            func(ctx context.Context, ids []int) ([]ayah.Ayah, error)
 	GetBySurahFunc          func(ctx context.Context, surahID, from, to int) ([]ayah.Ayah, error)
+	GetByIDRangeFunc        func(ctx context.Context, fromID, toID, limit, offset int) ([]ayah.Ayah, error)
 	GetBySurahAndNumberFunc func(ctx context.Context, surahID, number int) (*ayah.Ayah, error)
 	GetRandomFunc           func(ctx context.Context, surahID int) (*ayah.Ayah, error)
+	GetSajdaFunc            func(ctx context.Context) ([]ayah.SajdaAyah, error)
+	QueryFunc               func(ctx context.Context, f ayah.Filter) ([]ayah.Ayah, int, error)
 }
 
 func (m *MockAyahService) GetByID(ctx context.Context, id int) (*ayah.Ayah, error) {
@@ -36,6 +43,14 @@ func (m *MockAyahService) GetByID(ctx context.Context, id int) (*ayah.Ayah, erro
 	return nil, nil
 }
 
+func (m *MockAyahService) GetByIDs(ctx context.Context, ids []int) ([]ayah.Ayah, error) {
+	if m.GetByIDsFunc != nil {
+		return m.GetByIDsFunc(ctx, ids)
+	}
+
+	return nil, nil
+}
+
 func (m *MockAyahService) GetBySurah(ctx context.Context, surahID, from, to int) ([]ayah.Ayah, error) {
 	if m.GetBySurahFunc != nil {
 		return m.GetBySurahFunc(ctx, surahID, from, to)
@@ -44,6 +59,14 @@ func (m *MockAyahService) GetBySurah(ctx context.Context, surahID, from, to int)
 	return nil, nil
 }
 
+func (m *MockAyahService) GetByIDRange(ctx context.Context, fromID, toID, limit, offset int) ([]ayah.Ayah, error) {
+	if m.GetByIDRangeFunc != nil {
+		return m.GetByIDRangeFunc(ctx, fromID, toID, limit, offset)
+	}
+
+	return nil, nil
+}
+
 func (m *MockAyahService) GetBySurahAndNumber(ctx context.Context, surahID, number int) (*ayah.Ayah, error) {
 	if m.GetBySurahAndNumberFunc != nil {
 		return m.GetBySurahAndNumberFunc(ctx, surahID, number)
@@ -60,11 +83,32 @@ func (m *MockAyahService) GetRandom(ctx context.Context, surahID int) (*ayah.Aya
 	return nil, nil
 }
 
+func (m *MockAyahService) GetSajda(ctx context.Context) ([]ayah.SajdaAyah, error) {
+	if m.GetSajdaFunc != nil {
+		return m.GetSajdaFunc(ctx)
+	}
+
+	return nil, nil
+}
+
+func (m *MockAyahService) Query(ctx context.Context, f ayah.Filter) ([]ayah.Ayah, int, error) {
+	if m.QueryFunc != nil {
+		return m.QueryFunc(ctx, f)
+	}
+
+	return nil, 0, nil
+}
+
 type MockSurahService struct {
+	GetAllFunc  func(ctx context.Context) ([]surah.Surah, error)
 	GetByIDFunc func(ctx context.Context, id int) (*surah.Surah, error)
 }
 
 func (m *MockSurahService) GetAll(ctx context.Context) ([]surah.Surah, error) {
+	if m.GetAllFunc != nil {
+		return m.GetAllFunc(ctx)
+	}
+
 	return nil, nil
 }
 
@@ -76,10 +120,22 @@ func (m *MockSurahService) GetByID(ctx context.Context, id int) (*surah.Surah, e
 	return nil, nil
 }
 
+func (m *MockSurahService) GetByRevelationType(ctx context.Context, revelationType string) ([]surah.Surah, error) {
+	return nil, nil
+}
+
+func (m *MockSurahService) Search(ctx context.Context, q string, limit int) ([]surah.Match, error) {
+	return nil, nil
+}
+
 func setupRouter(h *handler.AyahHandler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
+	r.GET("/ayah", h.List)
 	r.GET("/ayah/:id", h.Detail)
+	r.GET("/ayah/:id/context", h.Context)
+	r.POST("/ayah/batch", h.Batch)
+	r.GET("/range", h.Range)
 	r.GET("/surah/:id/ayah", h.BySurah)
 	r.GET("/surah/:id/ayah/:number", h.BySurahAndNumber)
 	r.GET("/random", h.RandomAyah)
@@ -277,12 +333,50 @@ func TestAyahHandler_BySurah(t *testing.T) {
 		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, mockSurahService))
 
 		w := httptest.NewRecorder()
-		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/999/ayah", nil))
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/114/ayah", nil))
 
 		if w.Code != http.StatusNotFound {
 			t.Fatalf("expected status 404, got %d", w.Code)
 		}
 	})
+
+	t.Run("Surah out of range", func(t *testing.T) {
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/999/ayah", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("To beyond surah length", func(t *testing.T) {
+		mockSurahService := &MockSurahService{
+			GetByIDFunc: func(ctx context.Context, id int) (*surah.Surah, error) {
+				return &surah.Surah{ID: 1, NumberOfAyahs: 7}, nil
+			},
+		}
+
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, mockSurahService))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/1/ayah?from=3&to=8", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+		var body struct {
+			Code    string         `json:"code"`
+			Details map[string]any `json:"details"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatalf("invalid json: %v", err)
+		}
+		if body.Code != "INVALID_AYAH_RANGE" || body.Details["param"] != "to" || body.Details["max"] != float64(7) {
+			t.Errorf("unexpected error body %s", w.Body)
+		}
+	})
 }
 
 func TestAyahHandler_Detail(t *testing.T) {
@@ -333,6 +427,12 @@ func TestAyahHandler_Detail(t *testing.T) {
 		if data["translation"] != "Dengan nama Allah" {
 			t.Fatalf("expected Indonesian translation, got %v", data["translation"])
 		}
+		if data["prev"] != nil {
+			t.Fatalf("expected no prev for the first ayah, got %v", data["prev"])
+		}
+		if next, ok := data["next"].(map[string]any); !ok || next["id"] != float64(2) || next["key"] != "1:2" {
+			t.Fatalf("expected next 2 (1:2), got %v", data["next"])
+		}
 
 		surahInfo, ok := data["surah_info"].(map[string]any)
 		if !ok {
@@ -377,6 +477,104 @@ func TestAyahHandler_Detail(t *testing.T) {
 		}
 	})
 
+	t.Run("Success all langs returns translations map", func(t *testing.T) {
+		mockAyahService := &MockAyahService{
+			GetByIDFunc: func(ctx context.Context, id int) (*ayah.Ayah, error) {
+				return &ayah.Ayah{
+					ID:             2,
+					SurahID:        1,
+					NumberInSurah:  2,
+					TranslationIdo: "Segala puji",
+					TranslationEn:  "All praise",
+				}, nil
+			},
+		}
+		mockSurahService := &MockSurahService{
+			GetByIDFunc: func(ctx context.Context, id int) (*surah.Surah, error) {
+				return &surah.Surah{ID: 1, NameLatin: "Al-Fatihah"}, nil
+			},
+		}
+
+		r := setupRouter(handler.NewAyahHandler(mockAyahService, mockSurahService))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah/2?lang=all", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", w.Code)
+		}
+
+		data := decodeData(t, w.Body.Bytes())
+		if _, ok := data["translation"]; ok {
+			t.Fatalf("expected no single translation, got %v", data["translation"])
+		}
+		translations, ok := data["translations"].(map[string]any)
+		if !ok || translations["id"] != "Segala puji" || translations["en"] != "All praise" {
+			t.Fatalf("unexpected translations: %v", data["translations"])
+		}
+	})
+
+	t.Run("Accept-Language selects translation and error language", func(t *testing.T) {
+		mockAyahService := &MockAyahService{
+			GetByIDFunc: func(ctx context.Context, id int) (*ayah.Ayah, error) {
+				if id != 2 {
+					return nil, nil
+				}
+				return &ayah.Ayah{ID: 2, SurahID: 1, NumberInSurah: 2, TranslationIdo: "Segala puji", TranslationEn: "All praise"}, nil
+			},
+		}
+		mockSurahService := &MockSurahService{
+			GetByIDFunc: func(ctx context.Context, id int) (*surah.Surah, error) {
+				return &surah.Surah{ID: 1, NameLatin: "Al-Fatihah"}, nil
+			},
+		}
+
+		gin.SetMode(gin.TestMode)
+		r := gin.New()
+		r.Use(middleware.Language())
+		h := handler.NewAyahHandler(mockAyahService, mockSurahService)
+		r.GET("/ayah/:id", h.Detail)
+
+		req := httptest.NewRequest(http.MethodGet, "/ayah/2", nil)
+		req.Header.Set("Accept-Language", "fr, en-GB;q=0.9, id;q=0.5")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", w.Code)
+		}
+		if got := w.Header().Get("Content-Language"); got != "en" {
+			t.Fatalf("expected Content-Language en, got %q", got)
+		}
+		if got := w.Header().Get("Vary"); got != "Accept-Language" {
+			t.Fatalf("expected Vary Accept-Language, got %q", got)
+		}
+		if data := decodeData(t, w.Body.Bytes()); data["translation"] != "All praise" {
+			t.Fatalf("expected english translation, got %v", data["translation"])
+		}
+
+		req = httptest.NewRequest(http.MethodGet, "/ayah/3", nil)
+		req.Header.Set("Accept-Language", "id-ID")
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("expected status 404, got %d", w.Code)
+		}
+		if body := decodeBody(t, w.Body.Bytes()); body["error"] != "ayat tidak ditemukan" {
+			t.Fatalf("expected indonesian error, got %v", body["error"])
+		}
+
+		req = httptest.NewRequest(http.MethodGet, "/ayah/3", nil)
+		req.Header.Set("Accept-Language", "*")
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if body := decodeBody(t, w.Body.Bytes()); body["error"] != "ayah not found" {
+			t.Fatalf("expected english error for *, got %v", body["error"])
+		}
+	})
+
 	t.Run("Invalid ayah id", func(t *testing.T) {
 		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
 
@@ -388,6 +586,22 @@ func TestAyahHandler_Detail(t *testing.T) {
 		}
 	})
 
+	t.Run("Out-of-range ayah id", func(t *testing.T) {
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
+
+		for _, path := range []string{"/ayah/99999", "/ayah/0"} {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("%s: expected status 400, got %d", path, w.Code)
+			}
+			if body := decodeBody(t, w.Body.Bytes()); body["error"] != "id must be between 1 and 6236" {
+				t.Fatalf("%s: expected range message, got %v", path, body["error"])
+			}
+		}
+	})
+
 	t.Run("Invalid lang", func(t *testing.T) {
 		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
 
@@ -548,13 +762,24 @@ func TestAyahHandler_BySurahAndNumber(t *testing.T) {
 		r := setupRouter(handler.NewAyahHandler(mockAyahService, &MockSurahService{}))
 
 		w := httptest.NewRecorder()
-		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/1/ayah/999", nil))
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/1/ayah/7", nil))
 
 		if w.Code != http.StatusNotFound {
 			t.Fatalf("expected status 404, got %d", w.Code)
 		}
 	})
 
+	t.Run("Ayah number beyond surah length", func(t *testing.T) {
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/1/ayah/999", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+
 	t.Run("Ayah service error", func(t *testing.T) {
 		mockAyahService := &MockAyahService{
 			GetBySurahAndNumberFunc: func(ctx context.Context, surahID, number int) (*ayah.Ayah, error) {
@@ -732,4 +957,606 @@ func TestAyahHandler_RandomAyah(t *testing.T) {
 			t.Fatalf("expected status 500, got %d", w.Code)
 		}
 	})
+
+	t.Run("Invalid surah_id", func(t *testing.T) {
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
+
+		for _, q := range []string{"abc", "-1", "115"} {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/random?surah_id="+q, nil))
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("surah_id=%s: expected status 400, got %d", q, w.Code)
+			}
+			if body := decodeBody(t, w.Body.Bytes()); body["code"] != "INVALID_SURAH_ID" {
+				t.Errorf("surah_id=%s: unexpected body %v", q, body)
+			}
+		}
+	})
+
+	t.Run("Seed picks the same ayah every time", func(t *testing.T) {
+		var picked []int
+		mockAyahService := &MockAyahService{
+			GetByIDFunc: func(ctx context.Context, id int) (*ayah.Ayah, error) {
+				picked = append(picked, id)
+				surahID, number, _ := reference.Locate(id)
+				return &ayah.Ayah{ID: id, SurahID: surahID, NumberInSurah: number}, nil
+			},
+			GetRandomFunc: func(ctx context.Context, surahID int) (*ayah.Ayah, error) {
+				t.Fatal("GetRandom called with a seed")
+				return nil, nil
+			},
+		}
+		mockSurahService := &MockSurahService{
+			GetByIDFunc: func(ctx context.Context, id int) (*surah.Surah, error) {
+				return &surah.Surah{ID: id, NameLatin: "Yasin"}, nil
+			},
+		}
+		r := setupRouter(handler.NewAyahHandler(mockAyahService, mockSurahService))
+
+		for i := 0; i < 2; i++ {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/random?seed=ramadan&surah_id=36", nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d", w.Code)
+			}
+			if data := decodeData(t, w.Body.Bytes()); data["surah_id"] != float64(36) {
+				t.Fatalf("seeded pick left surah 36: %v", data)
+			}
+		}
+		if len(picked) != 2 || picked[0] != picked[1] {
+			t.Errorf("expected the same ayah twice, got %v", picked)
+		}
+	})
+}
+
+func TestAyahHandler_Batch(t *testing.T) {
+	t.Run("Success keeps request order and resolves keys", func(t *testing.T) {
+		mockAyahService := &MockAyahService{
+			GetByIDsFunc: func(ctx context.Context, ids []int) ([]ayah.Ayah, error) {
+				if len(ids) != 3 || ids[0] != 262 || ids[1] != 1 || ids[2] != 2 {
+					t.Fatalf("expected ids [262 1 2], got %v", ids)
+				}
+				return []ayah.Ayah{
+					{ID: 262, SurahID: 2, NumberInSurah: 255, TranslationIdo: "Allah, tidak ada tuhan"},
+					{ID: 1, SurahID: 1, NumberInSurah: 1, TranslationIdo: "Dengan nama Allah"},
+					{ID: 2, SurahID: 1, NumberInSurah: 2, TranslationIdo: "Segala puji"},
+				}, nil
+			},
+		}
+		surahCalls := 0
+		mockSurahService := &MockSurahService{
+			GetAllFunc: func(ctx context.Context) ([]surah.Surah, error) {
+				surahCalls++
+				return []surah.Surah{
+					{ID: 1, Number: 1, NameLatin: "Al-Fatihah"},
+					{ID: 2, Number: 2, NameLatin: "Al-Baqarah"},
+				}, nil
+			},
+		}
+
+		r := setupRouter(handler.NewAyahHandler(mockAyahService, mockSurahService))
+
+		w := httptest.NewRecorder()
+		body := strings.NewReader(`{"ids": ["2:255", 1, "2"]}`)
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/ayah/batch", body))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		if surahCalls != 1 {
+			t.Fatalf("expected a single surah lookup, got %d", surahCalls)
+		}
+
+		decoded := decodeBody(t, w.Body.Bytes())
+		data, ok := decoded["data"].([]any)
+		if !ok || len(data) != 3 {
+			t.Fatalf("expected 3 ayahs, got %v", decoded["data"])
+		}
+		first := data[0].(map[string]any)
+		surahInfo := first["surah_info"].(map[string]any)
+		if first["id"] != float64(262) || surahInfo["name_latin"] != "Al-Baqarah" {
+			t.Fatalf("unexpected first ayah: %v", first)
+		}
+	})
+
+	t.Run("Ayah missing from the dataset is null", func(t *testing.T) {
+		mockAyahService := &MockAyahService{
+			GetByIDsFunc: func(ctx context.Context, ids []int) ([]ayah.Ayah, error) {
+				return []ayah.Ayah{{ID: 2, SurahID: 1, NumberInSurah: 2}}, nil
+			},
+		}
+		r := setupRouter(handler.NewAyahHandler(mockAyahService, &MockSurahService{}))
+
+		w := httptest.NewRecorder()
+		body := strings.NewReader(`{"ids": [1, 2]}`)
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/ayah/batch", body))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		data := decodeBody(t, w.Body.Bytes())["data"].([]any)
+		if len(data) != 2 || data[0] != nil || data[1].(map[string]any)["id"] != float64(2) {
+			t.Fatalf("expected [null, ayah 2], got %v", data)
+		}
+	})
+
+	t.Run("Invalid key", func(t *testing.T) {
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
+
+		w := httptest.NewRecorder()
+		body := strings.NewReader(`{"ids": ["1:8"]}`)
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/ayah/batch", body))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("Empty ids", func(t *testing.T) {
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/ayah/batch", strings.NewReader(`{"ids": []}`)))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("Too many ids", func(t *testing.T) {
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
+
+		ids := strings.Repeat("1,", 100) + "1"
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/ayah/batch", strings.NewReader(`{"ids": [`+ids+`]}`)))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+}
+
+func TestAyahHandler_Range(t *testing.T) {
+	t.Run("Success inserts surah headers", func(t *testing.T) {
+		mockAyahService := &MockAyahService{
+			GetByIDRangeFunc: func(ctx context.Context, fromID, toID, limit, offset int) ([]ayah.Ayah, error) {
+				if fromID != 293 || toID != 303 || limit != 20 || offset != 0 {
+					t.Fatalf("expected 293-303 limit 20 offset 0, got %d-%d limit %d offset %d", fromID, toID, limit, offset)
+				}
+				return []ayah.Ayah{
+					{ID: 293, SurahID: 2, NumberInSurah: 286},
+					{ID: 294, SurahID: 3, NumberInSurah: 1},
+					{ID: 295, SurahID: 3, NumberInSurah: 2},
+				}, nil
+			},
+		}
+		mockSurahService := &MockSurahService{
+			GetAllFunc: func(ctx context.Context) ([]surah.Surah, error) {
+				return []surah.Surah{
+					{ID: 2, Number: 2, NameLatin: "Al-Baqarah"},
+					{ID: 3, Number: 3, NameLatin: "Ali 'Imran"},
+				}, nil
+			},
+		}
+
+		r := setupRouter(handler.NewAyahHandler(mockAyahService, mockSurahService))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/range?from=2:286&to=3:10", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+
+		data := decodeData(t, w.Body.Bytes())
+		if data["total"] != float64(11) {
+			t.Fatalf("expected total 11, got %v", data["total"])
+		}
+		items, ok := data["items"].([]any)
+		if !ok || len(items) != 5 {
+			t.Fatalf("expected 5 items (2 headers, 3 ayahs), got %v", data["items"])
+		}
+		types := make([]string, 0, len(items))
+		for _, item := range items {
+			types = append(types, item.(map[string]any)["type"].(string))
+		}
+		if strings.Join(types, ",") != "surah,ayah,surah,ayah,ayah" {
+			t.Fatalf("unexpected item order: %v", types)
+		}
+	})
+
+	t.Run("Reversed range", func(t *testing.T) {
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/range?from=3:1&to=2:1", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("Missing to", func(t *testing.T) {
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/range?from=3:1", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+}
+
+func TestAyahHandler_FieldsAndInclude(t *testing.T) {
+	mockAyahService := &MockAyahService{
+		GetBySurahFunc: func(ctx context.Context, surahID, from, to int) ([]ayah.Ayah, error) {
+			return []ayah.Ayah{{ID: 1, SurahID: 1, NumberInSurah: 1, TextUthmani: "بِسْمِ ٱللَّهِ ۚ", TranslationIdo: "Dengan nama Allah"}}, nil
+		},
+		GetByIDFunc: func(ctx context.Context, id int) (*ayah.Ayah, error) {
+			return &ayah.Ayah{ID: id, SurahID: 1, NumberInSurah: 1, TextUthmani: "بِسْمِ ٱللَّهِ ۚ"}, nil
+		},
+	}
+	mockSurahService := &MockSurahService{
+		GetByIDFunc: func(ctx context.Context, id int) (*surah.Surah, error) {
+			return &surah.Surah{ID: 1, Number: 1, NameLatin: "Al-Fatihah"}, nil
+		},
+		GetAllFunc: func(ctx context.Context) ([]surah.Surah, error) {
+			return []surah.Surah{{ID: 1, Number: 1, NameLatin: "Al-Fatihah", NameArabic: "الفاتحة", NumberOfAyahs: 7}}, nil
+		},
+	}
+	r := setupRouter(handler.NewAyahHandler(mockAyahService, mockSurahService))
+
+	t.Run("Fields trims ayahs and keeps envelope", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/1/ayah?fields=text_uthmani", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		data := decodeData(t, w.Body.Bytes())
+		if _, ok := data["surah"].(map[string]any); !ok {
+			t.Fatalf("expected surah header to be kept, got %v", data)
+		}
+		item := data["ayahs"].([]any)[0].(map[string]any)
+		if len(item) != 1 || item["text_uthmani"] == nil {
+			t.Fatalf("expected only text_uthmani, got %v", item)
+		}
+	})
+
+	t.Run("Fields apply to items, not the header", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/1/ayah?fields=number", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		data := decodeData(t, w.Body.Bytes())
+		if header := data["surah"].(map[string]any); header["name_latin"] != "Al-Fatihah" {
+			t.Fatalf("expected the whole surah header, got %v", header)
+		}
+		item := data["ayahs"].([]any)[0].(map[string]any)
+		if len(item) != 1 || item["number"] == nil {
+			t.Fatalf("expected only number, got %v", item)
+		}
+	})
+
+	t.Run("Items share the id field of ayah details", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/1/ayah?fields=id,text_uthmani", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		item := decodeData(t, w.Body.Bytes())["ayahs"].([]any)[0].(map[string]any)
+		if len(item) != 2 || item["id"] != float64(1) {
+			t.Fatalf("expected id and text_uthmani, got %v", item)
+		}
+	})
+
+	t.Run("Omitted field is known", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah/1?fields=id,translations", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("Unknown field", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/1/ayah?fields=text_uthmani,verse", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+		if body := decodeBody(t, w.Body.Bytes()); body["code"] != "INVALID_PARAM" {
+			t.Fatalf("expected INVALID_PARAM, got %v", body["code"])
+		}
+	})
+
+	t.Run("Include surah and words", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah/1?include=surah,words", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		data := decodeData(t, w.Body.Bytes())
+		sur, ok := data["surah"].(map[string]any)
+		if !ok || sur["name_arabic"] != "الفاتحة" {
+			t.Fatalf("expected full surah, got %v", data["surah"])
+		}
+		words, ok := data["words"].([]any)
+		if !ok || len(words) != 2 {
+			t.Fatalf("expected 2 words without pause mark, got %v", data["words"])
+		}
+	})
+
+	t.Run("Include cite", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/1/ayah?include=cite&style=short", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		item := decodeData(t, w.Body.Bytes())["ayahs"].([]any)[0].(map[string]any)
+		if item["cite"] != "(Al-Fatihah 1:1)" {
+			t.Fatalf("expected short citation, got %v", item["cite"])
+		}
+	})
+
+	t.Run("Include cite with invalid style", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah/1?include=cite&style=mla", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("Unavailable include", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah/1?include=tafsir", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("Invalid field", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah/1?fields=id,Text", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+}
+
+func TestAyahHandler_BySurahFormats(t *testing.T) {
+	mockAyahService := &MockAyahService{
+		GetBySurahFunc: func(ctx context.Context, surahID, from, to int) ([]ayah.Ayah, error) {
+			return []ayah.Ayah{{ID: 1, SurahID: 1, NumberInSurah: 1, TextUthmani: "بِسْمِ", TranslationIdo: "Dengan nama Allah", TranslationEn: "In the name of Allah", JuzNumber: 1}}, nil
+		},
+	}
+	mockSurahService := &MockSurahService{
+		GetByIDFunc: func(ctx context.Context, id int) (*surah.Surah, error) {
+			return &surah.Surah{ID: 1, Number: 1, NameLatin: "Al-Fatihah", NumberOfAyahs: 7}, nil
+		},
+	}
+	r := setupRouter(handler.NewAyahHandler(mockAyahService, mockSurahService))
+
+	tests := []struct {
+		name, url, accept string
+		contentType       string
+		want              string
+	}{
+		{"csv via format", "/surah/1/ayah?format=csv&lang=id,en", "", "text/csv; charset=utf-8",
+			"id,surah_id,surah_name,number_in_surah,text_uthmani,translation_id,translation_en,juz\n1,1,Al-Fatihah,1,بِسْمِ,Dengan nama Allah,In the name of Allah,1\n"},
+		{"csv with fields", "/surah/1/ayah?format=csv&fields=number_in_surah,text_uthmani", "", "text/csv; charset=utf-8",
+			"number_in_surah,text_uthmani\n1,بِسْمِ\n"},
+		{"plain text via Accept", "/surah/1/ayah", "text/plain", "text/plain; charset=utf-8",
+			"بِسْمِ\nDengan nama Allah\n(QS. Al-Fatihah [1]: 1)\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			if tc.accept != "" {
+				req.Header.Set("Accept", tc.accept)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+			}
+			if got := w.Header().Get("Content-Type"); got != tc.contentType {
+				t.Fatalf("expected Content-Type %s, got %s", tc.contentType, got)
+			}
+			if got := w.Body.String(); got != tc.want {
+				t.Fatalf("unexpected body:\n%s\nwant\n%s", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("Citation uses the canonical surah name", func(t *testing.T) {
+		// Seeded datasets hold the Indonesian meaning in name_latin.
+		r := setupRouter(handler.NewAyahHandler(mockAyahService, &MockSurahService{
+			GetByIDFunc: func(ctx context.Context, id int) (*surah.Surah, error) {
+				return &surah.Surah{ID: 1, Number: 1, NameLatin: "Pembukaan", NumberOfAyahs: 7}, nil
+			},
+		}))
+		for url, want := range map[string]string{
+			"/surah/1/ayah?format=text":         "(QS. Al-Fatihah [1]: 1)\n",
+			"/surah/1/ayah?format=text&lang=en": "(QS. Al-Fatiha [1]: 1)\n",
+		} {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+			if lines := strings.SplitAfter(w.Body.String(), "\n"); lines[len(lines)-2] != want {
+				t.Errorf("%s: expected citation line %q, got %q", url, want, w.Body.String())
+			}
+		}
+	})
+
+	t.Run("Invalid format", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/1/ayah?format=xml", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+}
+
+func TestAyahHandler_Context(t *testing.T) {
+	var gotFrom, gotTo int
+	mockAyahService := &MockAyahService{
+		GetByIDRangeFunc: func(ctx context.Context, fromID, toID, limit, offset int) ([]ayah.Ayah, error) {
+			gotFrom, gotTo = fromID, toID
+			var ayahs []ayah.Ayah
+			for id := fromID; id <= toID; id++ {
+				surahID, number, _ := reference.Locate(id)
+				ayahs = append(ayahs, ayah.Ayah{ID: id, SurahID: surahID, NumberInSurah: number, TextUthmani: "text"})
+			}
+			return ayahs, nil
+		},
+	}
+	mockSurahService := &MockSurahService{
+		GetByIDFunc: func(ctx context.Context, id int) (*surah.Surah, error) {
+			return &surah.Surah{ID: id, NameLatin: "Surah"}, nil
+		},
+	}
+	r := setupRouter(handler.NewAyahHandler(mockAyahService, mockSurahService))
+
+	tests := []struct {
+		name                  string
+		url                   string
+		wantFrom, wantTo      int
+		wantBefore, wantAfter int
+		wantPrev, wantNext    string
+	}{
+		{"Default window", "/ayah/2:255/context", 260, 264, 2, 2, "2:254", "2:256"},
+		{"Stops at surah start", "/ayah/2:1/context?before=3&after=1", 8, 9, 0, 1, "1:7", "2:2"},
+		{"Crosses surah boundary", "/ayah/2:1/context?before=3&after=0&cross_surah=true", 5, 8, 3, 0, "1:7", "2:2"},
+		{"Clamped at mushaf end", "/ayah/6236/context?after=5&cross_surah=true", 6234, 6236, 2, 0, "114:5", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.url, nil))
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+			}
+			if gotFrom != tc.wantFrom || gotTo != tc.wantTo {
+				t.Fatalf("expected range %d-%d, got %d-%d", tc.wantFrom, tc.wantTo, gotFrom, gotTo)
+			}
+			data := decodeData(t, w.Body.Bytes())
+			if n := len(data["before"].([]any)); n != tc.wantBefore {
+				t.Fatalf("expected %d ayahs before, got %d", tc.wantBefore, n)
+			}
+			if n := len(data["after"].([]any)); n != tc.wantAfter {
+				t.Fatalf("expected %d ayahs after, got %d", tc.wantAfter, n)
+			}
+			center := data["ayah"].(map[string]any)
+			if prev := center["prev"].(map[string]any); prev["key"] != tc.wantPrev {
+				t.Fatalf("expected prev %s, got %v", tc.wantPrev, prev)
+			}
+			if tc.wantNext == "" {
+				if center["next"] != nil {
+					t.Fatalf("expected no next, got %v", center["next"])
+				}
+			} else if next := center["next"].(map[string]any); next["key"] != tc.wantNext {
+				t.Fatalf("expected next %s, got %v", tc.wantNext, next)
+			}
+		})
+	}
+
+	for _, url := range []string{"/ayah/2:255/context?before=11", "/ayah/2:255/context?after=-1", "/ayah/2:255/context?cross_surah=maybe", "/ayah/0/context"} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", url, w.Code)
+		}
+	}
+}
+
+func TestAyahHandler_List(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var got ayah.Filter
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{
+			QueryFunc: func(ctx context.Context, f ayah.Filter) ([]ayah.Ayah, int, error) {
+				got = f
+				return []ayah.Ayah{{ID: 6231, SurahID: 114, NumberInSurah: 1, JuzNumber: 30}}, 21, nil
+			},
+		}, &MockSurahService{}))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah?surah=an-nas&juz=30&revelation=meccan&sajda=false&min_words=3&sort=-words&page=2&limit=10", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		if got.SurahID != 114 || got.Juz != 30 || got.Revelation != "meccan" || got.Sajda == nil || *got.Sajda ||
+			got.MinWords != 3 || got.Sort != ayah.SortWordsDesc || got.Limit != 10 || got.Offset != 10 {
+			t.Errorf("unexpected filter %+v", got)
+		}
+
+		data := decodeData(t, w.Body.Bytes())
+		if len(data) != 1 || len(data["ayahs"].([]any)) != 1 {
+			t.Errorf("expected only ayahs in data, got %v", data)
+		}
+		if meta := decodeBody(t, w.Body.Bytes())["meta"].(map[string]any); meta["total"] != float64(21) || meta["total_pages"] != float64(3) {
+			t.Errorf("unexpected meta %v", meta)
+		}
+	})
+
+	t.Run("Defaults", func(t *testing.T) {
+		var got ayah.Filter
+		r := setupRouter(handler.NewAyahHandler(&MockAyahService{
+			QueryFunc: func(ctx context.Context, f ayah.Filter) ([]ayah.Ayah, int, error) {
+				got = f
+				return nil, 0, nil
+			},
+		}, &MockSurahService{}))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", w.Code)
+		}
+		want := ayah.Filter{Sort: ayah.SortMushaf, Limit: 20}
+		if got.Sajda != nil || got != want {
+			t.Errorf("unexpected filter %+v", got)
+		}
+		if data := decodeData(t, w.Body.Bytes()); len(data["ayahs"].([]any)) != 0 {
+			t.Errorf("expected empty ayahs, got %v", data["ayahs"])
+		}
+	})
+
+	for _, tc := range []struct {
+		query string
+		code  string
+	}{
+		{"sort=random", "INVALID_PARAM"},
+		{"sajda=maybe", "INVALID_PARAM"},
+		{"revelation=both", "INVALID_REVELATION_TYPE"},
+		{"juz=31", "INVALID_JUZ_NUMBER"},
+		{"surah=nope", "INVALID_SURAH_ID"},
+		{"min_words=0", "INVALID_PARAM"},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			r := setupRouter(handler.NewAyahHandler(&MockAyahService{}, &MockSurahService{}))
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah?"+tc.query, nil))
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status 400, got %d", w.Code)
+			}
+			if body := decodeBody(t, w.Body.Bytes()); body["code"] != tc.code {
+				t.Errorf("expected code %s, got %v", tc.code, body["code"])
+			}
+		})
+	}
 }
@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/pkg/citation"
+	"quran-api-go/pkg/reference"
+	"quran-api-go/pkg/response"
+)
+
+// cardFontPath is the Arabic subset of Amiri built by `make font`.
+const cardFontPath = "static/fonts/amiri-arabic.woff"
+
+const (
+	cardWidth     = 1080
+	cardMinHeight = 1080
+	cardPadding   = 96
+
+	arabicSize       = 56
+	arabicLineHeight = 104
+	arabicEmPerRune  = 0.42 // average advance of a joined Amiri letter
+	translationSize  = 30
+	translationLine  = 46
+	translationEm    = 0.5 // average advance of a sans-serif character
+	citationSize     = 26
+	sectionGap       = 56
+)
+
+type cardTheme struct {
+	Background string
+	Foreground string
+	Muted      string
+	Accent     string
+}
+
+var cardThemes = map[string]cardTheme{
+	"light": {Background: "#fdfcf8", Foreground: "#1c1c1c", Muted: "#555555", Accent: "#0f766e"},
+	"dark":  {Background: "#0f172a", Foreground: "#f8fafc", Muted: "#cbd5e1", Accent: "#5eead4"},
+	"sepia": {Background: "#f4ecd8", Foreground: "#3b2f1e", Muted: "#6b5a43", Accent: "#8b5e34"},
+}
+
+var (
+	cardFontOnce sync.Once
+	cardFontData string
+)
+
+// cardFont returns the embedded Arabic font as a base64 data URI, so cards
+// render the same wherever the SVG is opened.
+func cardFont() string {
+	cardFontOnce.Do(func() {
+		woff, err := staticFiles.ReadFile(cardFontPath)
+		if err == nil {
+			cardFontData = "data:font/woff;base64," + base64.StdEncoding.EncodeToString(woff)
+		}
+	})
+	return cardFontData
+}
+
+type CardHandler struct {
+	ayahService ayah.AyahService
+}
+
+func NewCardHandler(ayahService ayah.AyahService) *CardHandler {
+	return &CardHandler{ayahService: ayahService}
+}
+
+// Card godoc
+// @Summary     Ayah share card
+// @Description Render an ayah as an SVG card with the Arabic text, translation and a Kemenag-style citation. The Arabic font is embedded, so the card needs no external resources.
+// @Tags        Ayah
+// @Produce     image/svg+xml
+// @Param       id     path     string  true   "Global ayah ID (1-6236) or surah:ayah key"
+// @Param       theme  query    string  false  "Colour theme"  Enums(light, dark, sepia)  default(light)
+// @Param       lang   query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Success     200    {string} string  "SVG image"
+// @Failure     400    {object} response.ErrorResponse
+// @Failure     404    {object} response.ErrorResponse
+// @Failure     500    {object} response.ErrorResponse
+// @Router      /ayah/{id}/card.svg [get]
+func (h *CardHandler) Card(c *gin.Context) {
+	var p struct {
+		ayahPath
+		Theme string `query:"theme" default:"light" validate:"oneof=light dark sepia" code:"INVALID_THEME"`
+	}
+	if !bind(c, &p) {
+		return
+	}
+	ayahID, theme := int(p.ID), cardThemes[p.Theme]
+	langs, err := requestLangs(c)
+	if err != nil {
+		badLang(c)
+		return
+	}
+
+	ay, err := h.ayahService.GetByID(c.Request.Context(), ayahID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
+			return
+		}
+		response.InternalError(c)
+		return
+	}
+	if ay == nil {
+		response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
+		return
+	}
+
+	span := reference.Span{SurahID: ay.SurahID, From: ay.NumberInSurah, To: ay.NumberInSurah}
+	cite, err := citation.Format(citation.StyleKemenag, langs[0], span)
+	if err != nil {
+		response.InternalError(c)
+		return
+	}
+	translation, translations := translationByLang(*ay, langs)
+	var texts []string
+	if translations == nil {
+		texts = []string{translation}
+	} else {
+		for _, lang := range langs {
+			texts = append(texts, translations[lang])
+		}
+	}
+
+	c.Data(http.StatusOK, "image/svg+xml; charset=utf-8", renderCard(theme, ay.TextUthmani, texts, cite))
+}
+
+// renderCard lays out the card top to bottom: Arabic lines, a divider, each
+// translation, then the citation. The card is square unless the text needs
+// more height.
+func renderCard(theme cardTheme, arabic string, translations []string, cite string) []byte {
+	contentWidth := float64(cardWidth - 2*cardPadding)
+	arabicLines := wrapText(arabic, contentWidth/(arabicSize*arabicEmPerRune), arabicWidth)
+	var translationLines [][]string
+	for _, text := range translations {
+		translationLines = append(translationLines, wrapText(text, contentWidth/(translationSize*translationEm), latinWidth))
+	}
+
+	contentHeight := len(arabicLines)*arabicLineHeight + sectionGap + citationSize
+	for _, lines := range translationLines {
+		contentHeight += sectionGap + len(lines)*translationLine
+	}
+	height := max(cardMinHeight, contentHeight+2*cardPadding)
+	y := (height - contentHeight) / 2
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, cardWidth, height, cardWidth, height)
+	buf.WriteString(`<style>`)
+	if font := cardFont(); font != "" {
+		fmt.Fprintf(&buf, `@font-face{font-family:"Quran Card Arabic";src:url(%s) format("woff")}`, font)
+	}
+	fmt.Fprintf(&buf, `.ar{font-family:"Quran Card Arabic",serif;font-size:%dpx;fill:%s}`, arabicSize, theme.Foreground)
+	fmt.Fprintf(&buf, `.tr{font-family:system-ui,"Segoe UI",Roboto,Helvetica,Arial,sans-serif;font-size:%dpx;fill:%s}`, translationSize, theme.Muted)
+	fmt.Fprintf(&buf, `.ct{font-family:system-ui,"Segoe UI",Roboto,Helvetica,Arial,sans-serif;font-size:%dpx;font-weight:600;fill:%s}`, citationSize, theme.Accent)
+	buf.WriteString(`</style>`)
+	fmt.Fprintf(&buf, `<rect width="100%%" height="100%%" fill="%s"/>`, theme.Background)
+	fmt.Fprintf(&buf, `<rect x="32" y="32" width="%d" height="%d" rx="24" fill="none" stroke="%s" stroke-width="2"/>`, cardWidth-64, height-64, theme.Accent)
+
+	center := cardWidth / 2
+	for i, line := range arabicLines {
+		// Baselines sit about three quarters down each line box.
+		fmt.Fprintf(&buf, `<text class="ar" x="%d" y="%d" text-anchor="middle" direction="rtl">%s</text>`, center, y+i*arabicLineHeight+arabicLineHeight*3/4, html.EscapeString(line))
+	}
+	y += len(arabicLines) * arabicLineHeight
+
+	for _, lines := range translationLines {
+		fmt.Fprintf(&buf, `<line x1="%d" x2="%d" y1="%d" y2="%d" stroke="%s" stroke-width="2"/>`, center-60, center+60, y+sectionGap/2, y+sectionGap/2, theme.Accent)
+		y += sectionGap
+		for i, line := range lines {
+			fmt.Fprintf(&buf, `<text class="tr" x="%d" y="%d" text-anchor="middle">%s</text>`, center, y+i*translationLine+translationLine*3/4, html.EscapeString(line))
+		}
+		y += len(lines) * translationLine
+	}
+
+	y += sectionGap
+	fmt.Fprintf(&buf, `<text class="ct" x="%d" y="%d" text-anchor="middle">%s</text>`, center, y+citationSize, html.EscapeString(cite))
+	buf.WriteString(`</svg>`)
+	return buf.Bytes()
+}
+
+// wrapText breaks text at spaces into lines no wider than limit, measured
+// with width. A word wider than limit gets a line of its own.
+func wrapText(text string, limit float64, width func(string) float64) []string {
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if line != "" && width(candidate) > limit {
+			lines = append(lines, line)
+			candidate = word
+		}
+		line = candidate
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// arabicWidth counts spacing characters; harakat and Quranic annotation
+// marks sit above or below letters and take no width.
+func arabicWidth(s string) float64 {
+	var n float64
+	for _, r := range s {
+		if !unicode.Is(unicode.Mn, r) && !unicode.Is(unicode.Cf, r) {
+			n++
+		}
+	}
+	return n
+}
+
+func latinWidth(s string) float64 {
+	return float64(len([]rune(s)))
+}
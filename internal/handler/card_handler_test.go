@@ -0,0 +1,91 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/handler"
+)
+
+func TestCardHandler_Card(t *testing.T) {
+	mockAyahService := &MockAyahService{
+		GetByIDFunc: func(ctx context.Context, id int) (*ayah.Ayah, error) {
+			if id != 262 {
+				return nil, domain.ErrNotFound
+			}
+			return &ayah.Ayah{
+				ID:             262,
+				SurahID:        2,
+				NumberInSurah:  255,
+				TextUthmani:    strings.Repeat("ٱللَّهُ لَآ إِلَٰهَ إِلَّا هُوَ ", 8),
+				TranslationIdo: "Allah, tidak ada tuhan selain Dia. Yang Mahahidup, Yang terus menerus mengurus (makhluk-Nya) & tidak mengantuk",
+				TranslationEn:  "Allah - there is no deity except Him, the Ever-Living, the Sustainer of existence.",
+			}, nil
+		},
+	}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/ayah/:id/card.svg", handler.NewCardHandler(mockAyahService).Card)
+
+	t.Run("Renders SVG with embedded font", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah/2:255/card.svg?theme=dark", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "image/svg+xml") {
+			t.Fatalf("expected SVG content type, got %q", ct)
+		}
+		body := w.Body.String()
+		for _, want := range []string{
+			"data:font/woff;base64,d09GR", // "wOFF"
+			`fill="#0f172a"`,
+			"QS. Al-Baqarah [2]: 255",
+			"&amp; tidak mengantuk",
+		} {
+			if !strings.Contains(body, want) {
+				t.Errorf("expected card to contain %q", want)
+			}
+		}
+		if n := strings.Count(body, `class="ar"`); n < 2 {
+			t.Errorf("expected Arabic text to wrap over several lines, got %d", n)
+		}
+	})
+
+	t.Run("English translation and citation", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah/262/card.svg?lang=en", nil))
+
+		body := w.Body.String()
+		if !strings.Contains(body, "the Ever-Living") || !strings.Contains(body, "QS. Al-Baqara [2]: 255") {
+			t.Fatalf("expected English card, got %s", body[strings.LastIndex(body, "</style>"):])
+		}
+	})
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+	}{
+		{"Invalid theme", "/ayah/1/card.svg?theme=neon", http.StatusBadRequest},
+		{"Invalid id", "/ayah/abc/card.svg", http.StatusBadRequest},
+		{"Not found", "/ayah/1/card.svg", http.StatusNotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.url, nil))
+			if w.Code != tc.wantStatus {
+				t.Fatalf("expected status %d, got %d", tc.wantStatus, w.Code)
+			}
+		})
+	}
+}
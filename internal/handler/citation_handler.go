@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/pkg/citation"
+	"quran-api-go/pkg/reference"
+	"quran-api-go/pkg/response"
+	"quran-api-go/pkg/validator"
+)
+
+const invalidStyleMessage = "style must be one of kemenag, short, apa, chicago or turabian"
+
+// styleDetails names ?style and the citation styles it accepts.
+func styleDetails() response.Details {
+	allowed := make([]string, len(citation.Styles))
+	for i, style := range citation.Styles {
+		allowed[i] = string(style)
+	}
+	return response.OneOf("style", allowed...)
+}
+
+type CitationHandler struct{}
+
+type CitationResponse struct {
+	Reference string            `json:"reference"`
+	Style     string            `json:"style"`
+	Citation  string            `json:"citation,omitempty"`
+	Citations map[string]string `json:"citations,omitempty"`
+}
+
+func NewCitationHandler() *CitationHandler {
+	return &CitationHandler{}
+}
+
+// Cite godoc
+// @Summary     Cite an ayah
+// @Description Format a citation for an ayah, or a range within its surah via `to`. Styles: kemenag "QS. Al-Baqarah [2]: 255", short "(Al-Baqarah 2:255)", apa "(The Qur'an, 2:255)", chicago "Qur'an, Al-Baqara 2:255." and turabian "(Qur'an 2:255)". The language selects surah name spelling.
+// @Tags        Ayah
+// @Produce     json
+// @Param       id     path     string  true   "Global ayah ID (1-6236) or surah:ayah key"
+// @Param       to     query    int     false  "Last ayah number of a range in the same surah"
+// @Param       style  query    string  false  "Citation style"  Enums(kemenag, short, apa, chicago, turabian)  default(kemenag)
+// @Param       lang   query    string  false  "Language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Success     200    {object} response.SuccessResponse{data=CitationResponse}
+// @Failure     400    {object} response.ErrorResponse
+// @Router      /ayah/{id}/cite [get]
+func (h *CitationHandler) Cite(c *gin.Context) {
+	var p struct {
+		ayahPath
+		To int `query:"to" validate:"min=1" code:"INVALID_AYAH_NUMBER"`
+	}
+	if !bind(c, &p) {
+		return
+	}
+	globalID := int(p.ID)
+	style, err := citation.ParseStyle(c.Query("style"))
+	if err != nil {
+		response.BadRequest(c, domain.CodeInvalidCitationStyle, invalidStyleMessage, styleDetails())
+		return
+	}
+	langs, err := requestLangs(c)
+	if err != nil {
+		badLang(c)
+		return
+	}
+
+	surahID, number, _ := reference.Locate(globalID)
+	span := reference.Span{SurahID: surahID, From: number, To: number}
+	if p.To != 0 {
+		if count := reference.AyahCount(surahID); p.To < number || p.To > count {
+			badRequest(c, validator.RangeError("to", domain.CodeInvalidAyahNumber, number, count))
+			return
+		}
+		span.To = p.To
+	}
+
+	result := CitationResponse{Reference: span.String(), Style: string(style)}
+	for _, lang := range langs {
+		text, err := citation.Format(style, lang, span)
+		if err != nil {
+			badRequest(c, err)
+			return
+		}
+		if len(langs) == 1 {
+			result.Citation = text
+			break
+		}
+		if result.Citations == nil {
+			result.Citations = make(map[string]string, len(langs))
+		}
+		result.Citations[lang] = text
+	}
+
+	response.Success(c, result)
+}
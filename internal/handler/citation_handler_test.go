@@ -0,0 +1,61 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/handler"
+)
+
+func TestCitationHandler_Cite(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/ayah/:id/cite", handler.NewCitationHandler().Cite)
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		want       string
+	}{
+		{"Default kemenag by key", "/ayah/2:255/cite", http.StatusOK, "QS. Al-Baqarah [2]: 255"},
+		{"Global id", "/ayah/262/cite?style=short", http.StatusOK, "(Al-Baqarah 2:255)"},
+		{"APA in English", "/ayah/2:255/cite?style=apa&lang=en", http.StatusOK, "(The Qur'an, 2:255)"},
+		{"Chicago range", "/ayah/2:255/cite?style=chicago&lang=en&to=257", http.StatusOK, "Qur'an, Al-Baqara 2:255–257."},
+		{"Turabian", "/ayah/1:1/cite?style=turabian&lang=en", http.StatusOK, "(Qur'an 1:1)"},
+		{"Invalid style", "/ayah/2:255/cite?style=mla", http.StatusBadRequest, ""},
+		{"Invalid id", "/ayah/2:300/cite", http.StatusBadRequest, ""},
+		{"Range past surah end", "/ayah/1:1/cite?to=8", http.StatusBadRequest, ""},
+		{"Range before start", "/ayah/2:255/cite?to=254", http.StatusBadRequest, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.url, nil))
+
+			if w.Code != tc.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.wantStatus, w.Code, w.Body.String())
+			}
+			if tc.want == "" {
+				return
+			}
+			if got := decodeData(t, w.Body.Bytes())["citation"]; got != tc.want {
+				t.Fatalf("expected %q, got %v", tc.want, got)
+			}
+		})
+	}
+
+	t.Run("Multiple languages", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ayah/2:255/cite?lang=id,en", nil))
+
+		data := decodeData(t, w.Body.Bytes())
+		citations, ok := data["citations"].(map[string]any)
+		if !ok || citations["id"] != "QS. Al-Baqarah [2]: 255" || citations["en"] != "QS. Al-Baqara [2]: 255" {
+			t.Fatalf("expected per-language citations, got %v", data)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/pkg/daily"
+	"quran-api-go/pkg/response"
+)
+
+// DailyHandler serves the ayah of the day. The pick is a hash of the date
+// (see pkg/daily), so every client gets the same ayah for the same day with
+// no state kept on the server.
+type DailyHandler struct {
+	ayahService  ayah.AyahService
+	surahService surah.SurahService
+	zone         *time.Location
+	pool         daily.Pool
+	now          func() time.Time
+}
+
+// DailyResponse is the ayah of the day and the day it belongs to.
+type DailyResponse struct {
+	Date     string             `json:"date" example:"2026-10-19"`
+	Timezone string             `json:"timezone" example:"Asia/Jakarta"`
+	Theme    string             `json:"theme,omitempty" example:"sabar"`
+	Ayah     AyahDetailResponse `json:"ayah"`
+}
+
+// NewDailyHandler creates a DailyHandler. zone decides when a day starts
+// unless the request passes ?tz=; pool is the curated list drawn from
+// without ?theme=, or nil for the whole mushaf.
+func NewDailyHandler(ayahService ayah.AyahService, surahService surah.SurahService, zone *time.Location, pool daily.Pool) *DailyHandler {
+	if len(pool) == 0 {
+		pool = daily.Mushaf()
+	}
+	return &DailyHandler{
+		ayahService:  ayahService,
+		surahService: surahService,
+		zone:         zone,
+		pool:         pool,
+		now:          time.Now,
+	}
+}
+
+// Daily godoc
+// @Summary     Ayah of the day
+// @Description The same ayah for everyone on a given calendar day. The day is today in tz (Asia/Jakarta by default) unless date is given; theme draws from a curated list instead of the default pool.
+// @Tags        Ayah
+// @Produce     json
+// @Param       date     query    string  false  "Calendar day, YYYY-MM-DD (default today in tz)"
+// @Param       tz       query    string  false  "IANA time zone deciding today"  default(Asia/Jakarta)
+// @Param       theme    query    string  false  "Curated theme"  Enums(doa, ilmu, rahmat, sabar, syukur)
+// @Param       lang     query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
+// @Success     200      {object} response.SuccessResponse{data=DailyResponse}
+// @Failure     400      {object} response.ErrorResponse
+// @Failure     404      {object} response.ErrorResponse
+// @Failure     500      {object} response.ErrorResponse
+// @Router      /daily [get]
+func (h *DailyHandler) Daily(c *gin.Context) {
+	var q struct {
+		Date  string `query:"date"`
+		TZ    string `query:"tz"`
+		Theme string `query:"theme"`
+	}
+	if !bind(c, &q) {
+		return
+	}
+
+	zone := h.zone
+	if q.TZ != "" {
+		loc, err := time.LoadLocation(q.TZ)
+		if err != nil || strings.EqualFold(q.TZ, "local") {
+			badRequest(c, paramErrorf(domain.CodeInvalidParam, response.Param("tz"), "unknown time zone %q", q.TZ))
+			return
+		}
+		zone = loc
+	}
+
+	now := h.now().In(zone)
+	day := now
+	maxAge := int(startOfNextDay(now).Sub(now).Seconds())
+	if q.Date != "" {
+		parsed, err := time.ParseInLocation(time.DateOnly, q.Date, zone)
+		if err != nil {
+			badRequest(c, paramErrorf(domain.CodeInvalidParam, response.Param("date"), "date must be YYYY-MM-DD"))
+			return
+		}
+		day, maxAge = parsed, 86400
+	}
+
+	pool := h.pool
+	if q.Theme != "" {
+		themed, ok := daily.Theme(q.Theme)
+		if !ok {
+			badRequest(c, paramErrorf(domain.CodeInvalidTheme, response.OneOf("theme", daily.Themes()...), "unknown theme"))
+			return
+		}
+		pool = themed
+	}
+
+	langs, err := requestLangs(c)
+	if err != nil {
+		badLang(c)
+		return
+	}
+
+	ay, err := h.ayahService.GetByID(c.Request.Context(), pool.Pick(daily.DaySeed(day, q.Theme)))
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
+			return
+		}
+		response.InternalError(c)
+		return
+	}
+	if ay == nil {
+		response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
+		return
+	}
+	detail, ok := loadAyahDetail(c, h.surahService, *ay, langs)
+	if !ok {
+		return
+	}
+
+	// Today's ayah changes at midnight in zone; a past or future day never does.
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+	respond(c, DailyResponse{
+		Date:     day.Format(time.DateOnly),
+		Timezone: zone.String(),
+		Theme:    q.Theme,
+		Ayah:     detail,
+	}, h.surahService)
+}
+
+func startOfNextDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, t.Location())
+}
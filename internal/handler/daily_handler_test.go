@@ -0,0 +1,134 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/internal/handler"
+	"quran-api-go/pkg/daily"
+	"quran-api-go/pkg/reference"
+)
+
+func setupDailyRouter(t *testing.T, pool daily.Pool) (*gin.Engine, *[]int) {
+	t.Helper()
+
+	jakarta, err := time.LoadLocation(daily.DefaultZone)
+	if err != nil {
+		t.Fatalf("load zone: %v", err)
+	}
+	var picked []int
+	ayahService := &MockAyahService{
+		GetByIDFunc: func(ctx context.Context, id int) (*ayah.Ayah, error) {
+			picked = append(picked, id)
+			surahID, number, _ := reference.Locate(id)
+			return &ayah.Ayah{ID: id, SurahID: surahID, NumberInSurah: number}, nil
+		},
+	}
+	surahService := &MockSurahService{
+		GetByIDFunc: func(ctx context.Context, id int) (*surah.Surah, error) {
+			return &surah.Surah{ID: id}, nil
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/daily", handler.NewDailyHandler(ayahService, surahService, jakarta, pool).Daily)
+	return r, &picked
+}
+
+func TestDailyHandler_Daily(t *testing.T) {
+	t.Run("Same day same ayah", func(t *testing.T) {
+		r, picked := setupDailyRouter(t, nil)
+
+		for i := 0; i < 2; i++ {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/daily?date=2026-10-19", nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d", w.Code)
+			}
+			data := decodeData(t, w.Body.Bytes())
+			if data["date"] != "2026-10-19" || data["timezone"] != "Asia/Jakarta" {
+				t.Fatalf("unexpected day: %v", data)
+			}
+			if w.Header().Get("Cache-Control") != "public, max-age=86400" {
+				t.Errorf("Cache-Control = %q", w.Header().Get("Cache-Control"))
+			}
+		}
+		want := daily.Mushaf().Pick("daily/2026-10-19")
+		if len(*picked) != 2 || (*picked)[0] != want || (*picked)[1] != want {
+			t.Errorf("expected ayah %d twice, got %v", want, *picked)
+		}
+	})
+
+	t.Run("Today expires at midnight", func(t *testing.T) {
+		r, _ := setupDailyRouter(t, nil)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/daily?tz=UTC", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", w.Code)
+		}
+		if data := decodeData(t, w.Body.Bytes()); data["timezone"] != "UTC" {
+			t.Errorf("unexpected timezone: %v", data)
+		}
+		if cc := w.Header().Get("Cache-Control"); !strings.HasPrefix(cc, "public, max-age=") || cc == "public, max-age=86400" {
+			t.Errorf("Cache-Control = %q", cc)
+		}
+	})
+
+	t.Run("Curated pool and theme", func(t *testing.T) {
+		pool, _ := daily.ParsePool("2:255")
+		r, picked := setupDailyRouter(t, pool)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/daily?date=2026-10-19", nil))
+		if w.Code != http.StatusOK || (*picked)[0] != 262 {
+			t.Fatalf("expected ayah 262, got status %d picks %v", w.Code, *picked)
+		}
+
+		sabar, _ := daily.Theme("sabar")
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/daily?date=2026-10-19&theme=sabar", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", w.Code)
+		}
+		if want := sabar.Pick("daily/2026-10-19/sabar"); (*picked)[1] != want {
+			t.Errorf("expected ayah %d, got %d", want, (*picked)[1])
+		}
+		if data := decodeData(t, w.Body.Bytes()); data["theme"] != "sabar" {
+			t.Errorf("unexpected theme: %v", data)
+		}
+	})
+
+	t.Run("Invalid params", func(t *testing.T) {
+		r, picked := setupDailyRouter(t, nil)
+
+		cases := map[string]string{
+			"tz=Mars/Olympus": "INVALID_PARAM",
+			"tz=Local":        "INVALID_PARAM",
+			"date=19-10-2026": "INVALID_PARAM",
+			"theme=sedih":     "INVALID_THEME",
+		}
+		for q, code := range cases {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/daily?"+q, nil))
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("%s: expected status 400, got %d", q, w.Code)
+			}
+			if body := decodeBody(t, w.Body.Bytes()); body["code"] != code {
+				t.Errorf("%s: unexpected body %v", q, body)
+			}
+		}
+		if len(*picked) != 0 {
+			t.Errorf("invalid requests reached the service: %v", *picked)
+		}
+	})
+}
@@ -47,7 +47,6 @@ func (h *DocsHandler) ServeDocs(c *gin.Context) {
 func (h *DocsHandler) ServeOpenAPI(c *gin.Context) {
 	c.Header("Content-Type", "text/yaml; charset=utf-8")
 	c.Header("Access-Control-Allow-Origin", "*")
-	c.Header("Cache-Control", "no-store")
 
 	// Read the swaggo-generated spec
 	content, err := os.ReadFile("./docs/api-reference/openapi.yaml")
@@ -61,10 +60,17 @@ func (h *DocsHandler) ServeOpenAPI(c *gin.Context) {
 	c.String(http.StatusOK, yaml)
 }
 
-// ServeStatic serves embedded static files (Scalar JS)
+// staticContentTypes lists the embedded files ServeStatic may serve.
+var staticContentTypes = map[string]string{
+	"scalar.js": "application/javascript",
+	"widget.js": "application/javascript",
+}
+
+// ServeStatic serves embedded static files (Scalar JS and the ayah widget)
 func (h *DocsHandler) ServeStatic(c *gin.Context) {
 	filename := filepath.Base(c.Param("filename"))
-	if filename != "scalar.js" {
+	contentType, ok := staticContentTypes[filename]
+	if !ok {
 		c.Status(http.StatusNotFound)
 		return
 	}
@@ -75,7 +81,7 @@ func (h *DocsHandler) ServeStatic(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/javascript", content)
+	c.Data(http.StatusOK, contentType, content)
 }
 
 func resolveDocsBaseURL(c *gin.Context) string {
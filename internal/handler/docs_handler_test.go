@@ -93,3 +93,29 @@ func TestDocsHandler_OpenAPIUsesProductionURLInReleaseMode(t *testing.T) {
 		t.Fatal("expected production URL in openapi output")
 	}
 }
+
+func TestDocsHandler_ServeStatic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/static/:filename", handler.NewDocsHandler().ServeStatic)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/static/widget.js", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/javascript" {
+		t.Errorf("expected application/javascript, got %q", ct)
+	}
+	if !strings.Contains(w.Body.String(), "data-quran-ref") {
+		t.Error("widget script doesn't look for data-quran-ref elements")
+	}
+
+	for _, path := range []string{"/static/OFL.txt", "/static/unknown.js"} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s: expected 404, got %d", path, w.Code)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/pkg/response"
+	"quran-api-go/pkg/validator"
+)
+
+const (
+	totalSurahs = 114
+	totalJuzs   = 30
+)
+
+// paramError is a validation failure found outside the handler body (in
+// parseShape, say) that already knows its error code and details. Like
+// validator.FieldError it keeps the format and arguments of its message, so
+// the format can be localised.
+type paramError struct {
+	code    domain.Code
+	details response.Details
+	format  string
+	args    []any
+}
+
+func paramErrorf(code domain.Code, details response.Details, format string, args ...any) *paramError {
+	return &paramError{code: code, details: details, format: format, args: args}
+}
+
+func (e *paramError) Error() string { return fmt.Sprintf(e.format, e.args...) }
+
+// badRequest writes a 400 for err. validator.Errors report the first
+// invalid parameter as code, message and details, and every one of them
+// under details.errors; a *paramError keeps its code and details; anything
+// else is INVALID_PARAM.
+func badRequest(c *gin.Context, err error) {
+	var fieldErrs validator.Errors
+	if errors.As(err, &fieldErrs) && len(fieldErrs) > 0 {
+		first := fieldErrs[0]
+		details := response.Param(first.Param)
+		if first.Min != nil {
+			details["min"] = *first.Min
+		}
+		if first.Max != nil {
+			details["max"] = *first.Max
+		}
+		if first.Allowed != nil {
+			details["allowed"] = first.Allowed
+		}
+		details["errors"] = fieldErrs
+		response.BadRequestf(c, first.Code, details, first.Format, first.Args...)
+		return
+	}
+	var pe *paramError
+	if errors.As(err, &pe) {
+		response.BadRequestf(c, pe.code, pe.details, pe.format, pe.args...)
+		return
+	}
+	response.BadRequest(c, domain.CodeInvalidParam, err.Error())
+}
+
+// bind binds and validates the request parameters into dst (see
+// validator.Bind). On failure it writes the error response and reports false:
+// a 404 when the path names a surah that does not exist, a 400 otherwise.
+func bind(c *gin.Context, dst any) bool {
+	if err := validator.Bind(c, dst); err != nil {
+		var fieldErrs validator.Errors
+		errors.As(err, &fieldErrs)
+		for _, fe := range fieldErrs {
+			if errors.Is(fe, errUnknownSurah) && c.Param(fe.Param) != "" {
+				response.NotFound(c, domain.CodeSurahNotFound, "surah not found", response.Param(fe.Param))
+				return false
+			}
+		}
+		badRequest(c, err)
+		return false
+	}
+	return true
+}
+
+// badLang writes the 400 for an unsupported ?lang.
+func badLang(c *gin.Context) {
+	response.BadRequest(c, domain.CodeInvalidLang, invalidLangMessage, response.OneOf("lang", "id", "en", "all"))
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/pkg/citation"
+	"quran-api-go/pkg/pagination"
+	"quran-api-go/pkg/reference"
+	"quran-api-go/pkg/response"
+)
+
+// respondList is respond for list endpoints that also render as CSV, NDJSON,
+// Markdown or plain text (see response.NegotiateFormat). table builds the
+// tabular form and is only called for those formats; ?fields= selects its
+// columns. meta is nil for unpaginated lists.
+func respondList(c *gin.Context, data any, table func() response.Table, meta *pagination.Meta, surahs surah.SurahService) {
+	format, err := response.NegotiateFormat(c)
+	if err != nil {
+		response.BadRequest(c, domain.CodeInvalidFormat, err.Error(), response.OneOf("format", "json", "csv", "ndjson", "markdown", "text"))
+		return
+	}
+	if format == response.FormatJSON {
+		if meta != nil {
+			respondPage(c, data, *meta, surahs)
+			return
+		}
+		respond(c, data, surahs)
+		return
+	}
+
+	if meta != nil {
+		if link := meta.Link(c.Request.URL); link != "" {
+			c.Writer.Header().Add("Link", link) // may join a successor-version link
+		}
+	}
+	response.Render(c, format, table().Select(splitList(c.Query("fields"))))
+}
+
+// ayahRow is the common shape of an ayah in tabular output.
+type ayahRow struct {
+	ID            int
+	SurahID       int
+	SurahName     string
+	NumberInSurah int
+	TextUthmani   string
+	Translation   string
+	Translations  map[string]string
+	Juz           int
+	Item          any // the JSON item, written as one NDJSON line
+}
+
+// newAyahTable builds the table for ayah lists. A single language gets a
+// translation column; several get one translation_<lang> column each.
+func newAyahTable(langs []string, rows []ayahRow) response.Table {
+	columns := []string{"id", "surah_id", "surah_name", "number_in_surah", "text_uthmani"}
+	if len(langs) == 1 {
+		columns = append(columns, "translation")
+	} else {
+		for _, lang := range langs {
+			columns = append(columns, "translation_"+lang)
+		}
+	}
+	columns = append(columns, "juz")
+
+	table := response.Table{Columns: columns}
+	for _, row := range rows {
+		values := []string{
+			strconv.Itoa(row.ID),
+			strconv.Itoa(row.SurahID),
+			row.SurahName,
+			strconv.Itoa(row.NumberInSurah),
+			row.TextUthmani,
+		}
+		text := []string{row.TextUthmani}
+		if len(langs) == 1 {
+			values = append(values, row.Translation)
+			text = append(text, row.Translation)
+		} else {
+			for _, lang := range langs {
+				values = append(values, row.Translations[lang])
+				text = append(text, row.Translations[lang])
+			}
+		}
+		values = append(values, strconv.Itoa(row.Juz))
+
+		// Cite by the canonical surah name rather than row.SurahName,
+		// which the dataset may hold in translation ("Pembukaan").
+		cite, _ := citation.Format(citation.StyleKemenag, langs[0], reference.Span{SurahID: row.SurahID, From: row.NumberInSurah, To: row.NumberInSurah})
+		table.Rows = append(table.Rows, response.TableRow{
+			Values:   values,
+			Item:     row.Item,
+			Text:     text,
+			Citation: cite,
+		})
+	}
+	return table
+}
@@ -2,29 +2,30 @@ package handler
 
 import (
 	"errors"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 
 	"quran-api-go/internal/domain"
 	"quran-api-go/internal/domain/juz"
+	"quran-api-go/internal/domain/surah"
 	"quran-api-go/pkg/pagination"
 	"quran-api-go/pkg/response"
-	"quran-api-go/pkg/validator"
 )
 
 type JuzHandler struct {
-	service juz.JuzService
+	service      juz.JuzService
+	surahService surah.SurahService
 }
 
 type JuzAyahListItem struct {
-	ID            int    `json:"id"`
-	SurahID       int    `json:"surah_id"`
-	SurahName     string `json:"surah_name"`
-	NumberInSurah int    `json:"number_in_surah"`
-	TextUthmani   string `json:"text_uthmani"`
-	Translation   string `json:"translation"`
-	JuzNumber     int    `json:"juz_number"`
+	ID            int               `json:"id"`
+	SurahID       int               `json:"surah_id"`
+	SurahName     string            `json:"surah_name"`
+	NumberInSurah int               `json:"number_in_surah"`
+	TextUthmani   string            `json:"text_uthmani"`
+	Translation   string            `json:"translation,omitempty"`
+	Translations  map[string]string `json:"translations,omitempty"`
+	JuzNumber     int               `json:"juz_number"`
 }
 
 type JuzAyahsResponse struct {
@@ -37,8 +38,8 @@ type JuzInfo struct {
 	TotalAyahs int `json:"total_ayahs"`
 }
 
-func NewJuzHandler(service juz.JuzService) *JuzHandler {
-	return &JuzHandler{service: service}
+func NewJuzHandler(service juz.JuzService, surahService surah.SurahService) *JuzHandler {
+	return &JuzHandler{service: service, surahService: surahService}
 }
 
 // List godoc
@@ -46,6 +47,8 @@ func NewJuzHandler(service juz.JuzService) *JuzHandler {
 // @Description Get a list of all 30 juz (parts) of the Quran
 // @Tags        Juz
 // @Produce     json
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
 // @Success     200  {object} response.SuccessResponse{data=[]juz.Juz}
 // @Failure     500  {object} response.ErrorResponse
 // @Router      /juz [get]
@@ -55,7 +58,7 @@ func (h *JuzHandler) List(c *gin.Context) {
 		response.InternalError(c)
 		return
 	}
-	response.Success(c, juzs)
+	respond(c, juzs, h.surahService)
 }
 
 // Detail godoc
@@ -64,77 +67,102 @@ func (h *JuzHandler) List(c *gin.Context) {
 // @Tags        Juz
 // @Produce     json
 // @Param       number  path     int  true  "Juz number (1-30)"  minimum(1)  maximum(30)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
 // @Success     200     {object} response.SuccessResponse{data=juz.Juz}
 // @Failure     400     {object} response.ErrorResponse
 // @Failure     404     {object} response.ErrorResponse
 // @Failure     500     {object} response.ErrorResponse
 // @Router      /juz/{number} [get]
 func (h *JuzHandler) Detail(c *gin.Context) {
-	number, err := strconv.Atoi(c.Param("number"))
-	if err != nil {
-		response.BadRequest(c, "invalid juz number")
+	var p juzPath
+	if !bind(c, &p) {
 		return
 	}
-	j, err := h.service.GetByNumber(c.Request.Context(), number)
+	j, err := h.service.GetByNumber(c.Request.Context(), p.Number)
 	if err != nil {
 		response.InternalError(c)
 		return
 	}
 	if j == nil {
-		response.NotFound(c, "juz not found")
+		response.NotFound(c, domain.CodeJuzNotFound, "juz not found")
 		return
 	}
-	response.Success(c, j)
+	respond(c, j, h.surahService)
 }
 
 // Ayahs godoc
 // @Summary     Get ayahs by juz
 // @Description Get all ayahs from a specific juz with pagination
 // @Tags        Juz
-// @Produce     json
+// @Produce     json,text/csv,application/x-ndjson,text/markdown,text/plain
 // @Param       number  path     int     true   "Juz number (1-30)"  minimum(1)  maximum(30)
 // @Param       page    query    int     false  "Page number"  minimum(1)  default(1)
-// @Param       limit   query    int     false  "Items per page"  minimum(1)  maximum(100)  default(50)
-// @Param       lang    query    string  false  "Translation language"  Enums(id, en)  default(id)
-// @Success     200     {object} response.SuccessResponse{data=JuzAyahsResponse}
+// @Param       limit   query    int     false  "Items per page"  minimum(1)  maximum(100)  default(20)
+// @Param       lang    query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
+// @Param       format   query    string  false  "Output format (overrides Accept)"  Enums(json, csv, ndjson, markdown, text)
+// @Success     200     {object} response.PageResponse{data=JuzAyahsResponse}
+// @Header      200     {string} Link "RFC 8288 links to the first, prev, next and last pages"
 // @Failure     400     {object} response.ErrorResponse
 // @Failure     404     {object} response.ErrorResponse
 // @Failure     500     {object} response.ErrorResponse
 // @Router      /juz/{number}/ayah [get]
 func (h *JuzHandler) Ayahs(c *gin.Context) {
-	number, err := strconv.Atoi(c.Param("number"))
-	if err != nil {
-		response.BadRequest(c, "invalid juz number")
+	var p struct {
+		juzPath
+		pageQuery
+	}
+	if !bind(c, &p) {
 		return
 	}
-	lang, err := validator.ValidateLang(c.Query("lang"))
+	langs, err := requestLangs(c)
 	if err != nil {
-		response.BadRequest(c, "lang must be 'id' or 'en'")
+		badLang(c)
 		return
 	}
-	params := pagination.Parse(c.Query("page"), c.Query("limit"))
-	j, err := h.service.GetByNumber(c.Request.Context(), number)
+	params := p.params()
+	j, err := h.service.GetByNumber(c.Request.Context(), p.Number)
 	if err != nil {
 		response.InternalError(c)
 		return
 	}
 	if j == nil {
-		response.NotFound(c, "juz not found")
+		response.NotFound(c, domain.CodeJuzNotFound, "juz not found")
 		return
 	}
-	ayahs, err := h.service.GetAyahsByJuz(c.Request.Context(), number, params.Limit, params.Offset)
+	ayahs, err := h.service.GetAyahsByJuz(c.Request.Context(), p.Number, params.Limit, params.Offset)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			response.NotFound(c, "ayahs not found")
+			response.NotFound(c, domain.CodeAyahNotFound, "ayahs not found")
 			return
 		}
 		response.InternalError(c)
 		return
 	}
-	response.Success(c, JuzAyahsResponse{
+	result := JuzAyahsResponse{
 		Juz:   JuzInfo{JuzNumber: j.JuzNumber, TotalAyahs: j.TotalAyahs},
-		Ayahs: newJuzAyahsResponse(ayahs, lang),
-	})
+		Ayahs: newJuzAyahsResponse(ayahs, langs),
+	}
+	meta := pagination.NewMeta(params, j.TotalAyahs)
+	respondList(c, result, func() response.Table {
+		rows := make([]ayahRow, 0, len(result.Ayahs))
+		for _, item := range result.Ayahs {
+			rows = append(rows, ayahRow{
+				ID:            item.ID,
+				SurahID:       item.SurahID,
+				SurahName:     item.SurahName,
+				NumberInSurah: item.NumberInSurah,
+				TextUthmani:   item.TextUthmani,
+				Translation:   item.Translation,
+				Translations:  item.Translations,
+				Juz:           item.JuzNumber,
+				Item:          item,
+			})
+		}
+		return newAyahTable(langs, rows)
+	}, &meta, h.surahService)
 }
 
 // Surahs godoc
@@ -143,36 +171,34 @@ func (h *JuzHandler) Ayahs(c *gin.Context) {
 // @Tags        Juz
 // @Produce     json
 // @Param       number  path     int  true  "Juz number (1-30)"  minimum(1)  maximum(30)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
 // @Success     200     {object} response.SuccessResponse{data=[]juz.JuzSurah}
 // @Failure     400     {object} response.ErrorResponse
 // @Failure     404     {object} response.ErrorResponse
 // @Failure     500     {object} response.ErrorResponse
 // @Router      /juz/{number}/surah [get]
 func (h *JuzHandler) Surahs(c *gin.Context) {
-	number, err := strconv.Atoi(c.Param("number"))
-	if err != nil || number < 1 || number > 30 {
-		response.BadRequest(c, "invalid juz number")
+	var p juzPath
+	if !bind(c, &p) {
 		return
 	}
-	surahs, err := h.service.GetSurahsByJuz(c.Request.Context(), number)
+	surahs, err := h.service.GetSurahsByJuz(c.Request.Context(), p.Number)
 	if err != nil {
 		response.InternalError(c)
 		return
 	}
 	if surahs == nil {
-		response.NotFound(c, "juz not found")
+		response.NotFound(c, domain.CodeJuzNotFound, "juz not found")
 		return
 	}
-	response.Success(c, surahs)
+	respond(c, surahs, h.surahService)
 }
 
-func newJuzAyahsResponse(ayahs []juz.JuzAyah, lang string) []JuzAyahListItem {
+func newJuzAyahsResponse(ayahs []juz.JuzAyah, langs []string) []JuzAyahListItem {
 	result := make([]JuzAyahListItem, 0, len(ayahs))
 	for _, item := range ayahs {
-		translation := item.TranslationIdo
-		if lang == "en" {
-			translation = item.TranslationEn
-		}
+		translation, translations := translationFor(langs, item.TranslationIdo, item.TranslationEn)
 		result = append(result, JuzAyahListItem{
 			ID:            item.AyahID,
 			SurahID:       item.SurahID,
@@ -180,6 +206,7 @@ func newJuzAyahsResponse(ayahs []juz.JuzAyah, lang string) []JuzAyahListItem {
 			NumberInSurah: item.NumberInSurah,
 			TextUthmani:   item.TextUthmani,
 			Translation:   translation,
+			Translations:  translations,
 			JuzNumber:     item.JuzNumber,
 		})
 	}
@@ -38,7 +38,7 @@ func TestJuzHandler_ListResponse_IncludesTotalAyahs(t *testing.T) {
 
 	repo := repository.NewJuzRepository(db)
 	svc := service.NewJuzService(repo)
-	h := handler.NewJuzHandler(svc)
+	h := handler.NewJuzHandler(svc, &MockSurahService{})
 	r.GET("/juz", h.List)
 
 	w := httptest.NewRecorder()
@@ -99,7 +99,7 @@ func TestJuzHandler_AyahsResponse_WrappedStructure(t *testing.T) {
 
 	repo := repository.NewJuzRepository(db)
 	svc := service.NewJuzService(repo)
-	h := handler.NewJuzHandler(svc)
+	h := handler.NewJuzHandler(svc, &MockSurahService{})
 	r.GET("/juz/:number/ayah", h.Ayahs)
 
 	w := httptest.NewRecorder()
@@ -135,3 +135,54 @@ func getMapKeys(m map[string]any) []string {
 	}
 	return keys
 }
+
+func TestJuzHandler_InvalidNumber(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := handler.NewJuzHandler(nil, &MockSurahService{})
+	r.GET("/juz/:number", h.Detail)
+	r.GET("/juz/:number/ayah", h.Ayahs)
+	r.GET("/juz/:number/surah", h.Surahs)
+
+	for _, path := range []string{"/juz/0", "/juz/31", "/juz/x/ayah", "/juz/31/surah"} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", path, w.Code)
+		}
+	}
+}
+
+// TestJuzHandler_IncludeWithoutTarget verifies an include the juz has no place for is rejected
+func TestJuzHandler_IncludeWithoutTarget(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	db.ExecContext(context.Background(), `
+		CREATE TABLE juzs (id INTEGER PRIMARY KEY, juz_number INTEGER, first_ayah_id INTEGER, last_ayah_id INTEGER);
+		CREATE TABLE ayahs (id INTEGER PRIMARY KEY, juz_number INTEGER);
+
+		INSERT INTO juzs (id, juz_number, first_ayah_id, last_ayah_id) VALUES (1, 1, 1, 7);
+		INSERT INTO ayahs (id, juz_number) VALUES (1, 1), (2, 1), (3, 1), (4, 1), (5, 1), (6, 1), (7, 1);
+	`)
+
+	h := handler.NewJuzHandler(service.NewJuzService(repository.NewJuzRepository(db)), &MockSurahService{})
+	r.GET("/juz", h.List)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/juz?include=surah", nil))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", w.Code, w.Body.String())
+	}
+	if body := decodeBody(t, w.Body.Bytes()); body["code"] != "INVALID_INCLUDE" {
+		t.Errorf("expected INVALID_INCLUDE, got %v", body["code"])
+	}
+}
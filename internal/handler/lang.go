@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/pkg/response"
+	"quran-api-go/pkg/validator"
+)
+
+const invalidLangMessage = "lang must be 'id', 'en', a comma-separated list of them, or 'all'"
+
+// translationFor picks the translation text for the requested languages.
+// A single language collapses to one string (the `translation` field);
+// several languages expand to a map keyed by language (the `translations`
+// field) and the string is left empty.
+func translationFor(langs []string, indo, en string) (string, map[string]string) {
+	if len(langs) == 1 {
+		if langs[0] == "en" {
+			return en, nil
+		}
+		return indo, nil
+	}
+
+	translations := make(map[string]string, len(langs))
+	for _, lang := range langs {
+		if lang == "en" {
+			translations[lang] = en
+		} else {
+			translations[lang] = indo
+		}
+	}
+	return "", translations
+}
+
+// requestLangs resolves the translation languages for a request: the lang
+// query parameter when present, otherwise the language negotiated from
+// Accept-Language by middleware.Language, otherwise Indonesian.
+// Content-Language is set to the resolved languages.
+func requestLangs(c *gin.Context) ([]string, error) {
+	query := c.Query("lang")
+	lang := query
+	if lang == "" {
+		lang = c.GetString(response.LangKey)
+	}
+
+	langs, err := validator.ValidateLangs(lang)
+	if err != nil {
+		return nil, err
+	}
+	if query != "" && len(langs) == 1 {
+		// An explicit single language also applies to error messages.
+		c.Set(response.LangKey, langs[0])
+	}
+	c.Header("Content-Language", strings.Join(langs, ", "))
+	return langs, nil
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"quran-api-go/pkg/pagination"
+	"quran-api-go/pkg/reference"
+)
+
+// Request parameters shared by several handlers, bound with bind.
+
+// pageQuery is ?page and ?limit of a paginated endpoint. A limit over 100 is
+// clamped by pagination.New rather than rejected, as it always has been.
+type pageQuery struct {
+	Page  int `query:"page" default:"1" validate:"min=1" code:"INVALID_PAGINATION"`
+	Limit int `query:"limit" default:"20" validate:"min=1" code:"INVALID_PAGINATION"`
+}
+
+func (q pageQuery) params() pagination.Params {
+	return pagination.New(q.Page, q.Limit)
+}
+
+// ayahID is a global ayah ID (1-6236) given as a number or a "surah:ayah"
+// key. Numbers are taken as they are, so that the min and max rules report
+// one out of range.
+type ayahID int
+
+func (id *ayahID) UnmarshalText(text []byte) error {
+	if n, err := strconv.Atoi(string(text)); err == nil {
+		*id = ayahID(n)
+		return nil
+	}
+	n, ok := AyahKey(text).GlobalID()
+	if !ok {
+		return errors.New("invalid ayah " + strconv.Quote(string(text)))
+	}
+	*id = ayahID(n)
+	return nil
+}
+
+// surahID is a surah number (1-114) given as a number or a name: a slug
+// ("al-baqarah") or any spelling reference.MatchSurah accepts ("Yaasiin").
+// Names stored only in the dataset ("Pembukaan") are not recognised.
+// A name that matches no surah fails with errUnknownSurah, which bind turns
+// into a 404 in a path and leaves a 400 in a query.
+type surahID int
+
+var errUnknownSurah = errors.New("unknown surah")
+
+func (id *surahID) UnmarshalText(text []byte) error {
+	if n, err := strconv.Atoi(string(text)); err == nil {
+		*id = surahID(n)
+		return nil
+	}
+	n, ok := reference.MatchSurah(string(text))
+	if !ok {
+		return fmt.Errorf("%w %q", errUnknownSurah, text)
+	}
+	*id = surahID(n)
+	return nil
+}
+
+// optionalBool is a boolean parameter that may be left out, for filters
+// where false and absent differ.
+type optionalBool struct {
+	set   bool
+	value bool
+}
+
+func (b *optionalBool) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseBool(string(text))
+	if err != nil {
+		return err
+	}
+	*b = optionalBool{set: true, value: v}
+	return nil
+}
+
+// ptr returns nil when the parameter was left out.
+func (b optionalBool) ptr() *bool {
+	if !b.set {
+		return nil
+	}
+	return &b.value
+}
+
+// surahPath is the :id of /surah/:id routes.
+type surahPath struct {
+	ID surahID `path:"id" validate:"required,min=1,max=114" code:"INVALID_SURAH_ID"`
+}
+
+// juzPath is the :number of /juz/:number routes.
+type juzPath struct {
+	Number int `path:"number" validate:"required,min=1,max=30" code:"INVALID_JUZ_NUMBER"`
+}
+
+// ayahPath is the :id of /ayah/:id routes.
+type ayahPath struct {
+	ID ayahID `path:"id" validate:"required,min=1,max=6236" code:"INVALID_AYAH_ID"`
+}
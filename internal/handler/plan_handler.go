@@ -0,0 +1,283 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/plan"
+	"quran-api-go/pkg/khatam"
+	"quran-api-go/pkg/reference"
+	"quran-api-go/pkg/response"
+	"quran-api-go/pkg/validator"
+)
+
+// PlanHandler serves reading plans.
+type PlanHandler struct {
+	planService plan.PlanService
+	zone        *time.Location
+	now         func() time.Time
+}
+
+// KhatamDay is one day of a khatam plan: the ayahs From to To inclusive.
+// Progress is the percentage of the mushaf's letters read by the end of
+// the day.
+type KhatamDay struct {
+	Day               int     `json:"day" example:"1"`
+	Date              string  `json:"date" example:"2027-02-08"`
+	From              string  `json:"from" example:"1:1"`
+	To                string  `json:"to" example:"2:141"`
+	FromID            int     `json:"from_id" example:"1"`
+	ToID              int     `json:"to_id" example:"148"`
+	FromPage          int     `json:"from_page,omitempty" example:"1"`
+	ToPage            int     `json:"to_page,omitempty" example:"21"`
+	Ayahs             int     `json:"ayahs" example:"148"`
+	Words             int     `json:"words" example:"2553"`
+	Letters           int     `json:"letters" example:"11273"`
+	RecitationSeconds int     `json:"recitation_seconds" example:"2801"`
+	Progress          float64 `json:"progress" example:"3.469"`
+}
+
+// KhatamResponse is a day-by-day schedule for reading the whole Quran.
+type KhatamResponse struct {
+	Preset   string      `json:"preset,omitempty" example:"ramadan"`
+	Start    string      `json:"start" example:"2027-02-08"`
+	End      string      `json:"end" example:"2027-03-09"`
+	Days     int         `json:"days" example:"30"`
+	Unit     string      `json:"unit" example:"juz"`
+	By       string      `json:"by" example:"balanced"`
+	Schedule []KhatamDay `json:"schedule"`
+}
+
+// NewPlanHandler creates a PlanHandler. zone decides which day is today
+// when a plan starts without an explicit date.
+func NewPlanHandler(planService plan.PlanService, zone *time.Location) *PlanHandler {
+	return &PlanHandler{
+		planService: planService,
+		zone:        zone,
+		now:         time.Now,
+	}
+}
+
+// Khatam godoc
+// @Summary     Khatam reading plan
+// @Description Splits the whole Quran into days portions that start and end on unit boundaries (juz, Madani page, or ayah - breaking between rukus where the day count allows) and are as even as possible in by: balanced (letters), words or ayahs. A preset fills in days, unit and start for Ramadan; explicit parameters win. Sent as iCalendar for Accept: text/calendar, or at /plans/khatam.ics.
+// @Tags        Plan
+// @Produce     json
+// @Produce     text/calendar
+// @Param       days    query    int     false  "Number of days (default 30, at most the number of units)"  minimum(1)
+// @Param       start   query    string  false  "First day, YYYY-MM-DD (default today, or the first of the preset's Ramadan)"
+// @Param       unit    query    string  false  "Division portions start and end on"  Enums(juz, page, ayah)  default(ayah)
+// @Param       by      query    string  false  "What to keep even between days"  Enums(balanced, words, ayahs)  default(balanced)
+// @Param       preset  query    string  false  "Ramadan preset"  Enums(ramadan, ramadan-29, ramadan-last10)
+// @Success     200     {object} response.SuccessResponse{data=KhatamResponse}
+// @Failure     400     {object} response.ErrorResponse
+// @Failure     500     {object} response.ErrorResponse
+// @Failure     503     {object} response.ErrorResponse
+// @Router      /plans/khatam [get]
+func (h *PlanHandler) Khatam(c *gin.Context) {
+	c.Writer.Header().Add("Vary", "Accept")
+	h.khatam(c, strings.Contains(c.GetHeader("Accept"), "text/calendar"))
+}
+
+// KhatamICS godoc
+// @Summary     Khatam reading plan as iCalendar
+// @Description The plan from /plans/khatam as one all-day event per day, for calendar apps to import or subscribe to. Takes the same parameters.
+// @Tags        Plan
+// @Produce     text/calendar
+// @Param       days    query    int     false  "Number of days (default 30, at most the number of units)"  minimum(1)
+// @Param       start   query    string  false  "First day, YYYY-MM-DD (default today, or the first of the preset's Ramadan)"
+// @Param       unit    query    string  false  "Division portions start and end on"  Enums(juz, page, ayah)  default(ayah)
+// @Param       by      query    string  false  "What to keep even between days"  Enums(balanced, words, ayahs)  default(balanced)
+// @Param       preset  query    string  false  "Ramadan preset"  Enums(ramadan, ramadan-29, ramadan-last10)
+// @Param       lang    query    string  false  "Language of the event titles: id or en"  default(id)
+// @Success     200     {string} string
+// @Failure     400     {object} response.ErrorResponse
+// @Failure     500     {object} response.ErrorResponse
+// @Failure     503     {object} response.ErrorResponse
+// @Router      /plans/khatam.ics [get]
+func (h *PlanHandler) KhatamICS(c *gin.Context) {
+	h.khatam(c, true)
+}
+
+func (h *PlanHandler) khatam(c *gin.Context, ics bool) {
+	var q struct {
+		Days   int    `query:"days" validate:"min=1"`
+		Start  string `query:"start"`
+		Unit   string `query:"unit" validate:"oneof=juz page ayah"`
+		By     string `query:"by" default:"balanced" validate:"oneof=balanced words ayahs"`
+		Preset string `query:"preset"`
+	}
+	if !bind(c, &q) {
+		return
+	}
+
+	var preset khatam.Preset
+	if q.Preset != "" {
+		var ok bool
+		if preset, ok = khatam.LookupPreset(q.Preset); !ok {
+			badRequest(c, paramErrorf(domain.CodeInvalidParam, response.OneOf("preset", khatam.Presets()...), "unknown preset"))
+			return
+		}
+	}
+	days := firstNonZero(q.Days, preset.Days, 30)
+	unit := plan.Unit(q.Unit)
+	if unit == "" {
+		unit = plan.Unit(preset.Unit)
+	}
+	if unit == "" {
+		unit = plan.UnitAyah
+	}
+	if days > unit.Size() {
+		badRequest(c, validator.RangeError("days", domain.CodeInvalidParam, 1, unit.Size()))
+		return
+	}
+
+	now := h.now().In(h.zone)
+	start := now
+	maxAge := int(startOfNextDay(now).Sub(now).Seconds())
+	switch {
+	case q.Start != "":
+		parsed, err := time.ParseInLocation(time.DateOnly, q.Start, h.zone)
+		if err != nil {
+			badRequest(c, paramErrorf(domain.CodeInvalidParam, response.Param("start"), "start must be YYYY-MM-DD"))
+			return
+		}
+		start, maxAge = parsed, 86400
+	case q.Preset != "":
+		ramadan, ok := khatam.RamadanStart(now)
+		if !ok {
+			badRequest(c, paramErrorf(domain.CodeInvalidParam, response.Param("start"), "start is required for this preset"))
+			return
+		}
+		start = ramadan.AddDate(0, 0, preset.Offset)
+	}
+	y, m, d := start.Date()
+	start = time.Date(y, m, d, 0, 0, 0, 0, h.zone)
+
+	portions, err := h.planService.Khatam(c.Request.Context(), days, unit, plan.Measure(q.By))
+	if err != nil {
+		if errors.Is(err, domain.ErrDatasetIncomplete) {
+			response.ServiceUnavailable(c, domain.CodeDatasetIncomplete, "the dataset lacks data for this plan")
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidRangeParam) {
+			// More days than the dataset has portions of unit.
+			badRequest(c, validator.RangeError("days", domain.CodeInvalidParam, 1, unit.Size()))
+			return
+		}
+		response.InternalError(c)
+		return
+	}
+
+	result := KhatamResponse{
+		Preset:   q.Preset,
+		Start:    start.Format(time.DateOnly),
+		End:      start.AddDate(0, 0, days-1).Format(time.DateOnly),
+		Days:     days,
+		Unit:     string(unit),
+		By:       q.By,
+		Schedule: make([]KhatamDay, len(portions)),
+	}
+	// Progress follows letters; datasets seeded without them fall back to
+	// ayahs.
+	weight := func(p plan.Portion) int { return p.Letters }
+	total := 0
+	for _, p := range portions {
+		total += p.Letters
+	}
+	if total == 0 {
+		weight, total = func(p plan.Portion) int { return p.Ayahs }, reference.TotalAyahs
+	}
+	read := 0
+	for i, p := range portions {
+		read += weight(p)
+		result.Schedule[i] = KhatamDay{
+			Day:               i + 1,
+			Date:              start.AddDate(0, 0, i).Format(time.DateOnly),
+			From:              fmt.Sprintf("%d:%d", p.FromSurah, p.FromAyah),
+			To:                fmt.Sprintf("%d:%d", p.ToSurah, p.ToAyah),
+			FromID:            p.FromID,
+			ToID:              p.ToID,
+			FromPage:          p.FromPage,
+			ToPage:            p.ToPage,
+			Ayahs:             p.Ayahs,
+			Words:             p.Words,
+			Letters:           p.Letters,
+			RecitationSeconds: (p.RecitationMS + 500) / 1000,
+			Progress:          math.Round(float64(read)*100000/float64(total)) / 1000,
+		}
+	}
+
+	// A plan starting today moves with the date; a dated one never does.
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+	if !ics {
+		respond(c, result, nil)
+		return
+	}
+
+	langs, err := requestLangs(c)
+	if err != nil {
+		badLang(c)
+		return
+	}
+	c.Header("Content-Disposition", `inline; filename="khatam.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", khatam.ICS(calendarName(result, langs[0]), h.now(), khatamEvents(result, portions, langs[0])))
+}
+
+func calendarName(result KhatamResponse, lang string) string {
+	if lang == "id" {
+		return fmt.Sprintf("Khatam Al-Quran (%d hari)", result.Days)
+	}
+	return fmt.Sprintf("Quran khatam (%d days)", result.Days)
+}
+
+// khatamEvents turns a plan into calendar events. UIDs depend only on the
+// plan's parameters, so re-importing an updated plan replaces its events.
+func khatamEvents(result KhatamResponse, portions []plan.Portion, lang string) []khatam.Event {
+	events := make([]khatam.Event, len(portions))
+	for i, p := range portions {
+		day := result.Schedule[i]
+		from := reference.SurahName(p.FromSurah, lang)
+		span := fmt.Sprintf("%s %d – %s %d", from, p.FromAyah, reference.SurahName(p.ToSurah, lang), p.ToAyah)
+		if p.FromSurah == p.ToSurah {
+			span = fmt.Sprintf("%s %d–%d", from, p.FromAyah, p.ToAyah)
+		}
+
+		summary, description, pages, minutes := "Khatam day %d: %s", "QS %s – %s, %d ayahs", ", p. %d–%d", ", about %d min"
+		if lang == "id" {
+			summary, description, pages, minutes = "Khatam hari ke-%d: %s", "QS %s – %s, %d ayat", ", hlm. %d–%d", ", sekitar %d menit"
+		}
+		description = fmt.Sprintf(description, day.From, day.To, day.Ayahs)
+		if p.FromPage > 0 {
+			description += fmt.Sprintf(pages, p.FromPage, p.ToPage)
+		}
+		if day.RecitationSeconds > 0 {
+			description += fmt.Sprintf(minutes, (day.RecitationSeconds+30)/60)
+		}
+
+		date, _ := time.Parse(time.DateOnly, day.Date)
+		events[i] = khatam.Event{
+			UID:         fmt.Sprintf("khatam-%s-%d-%s-%s-%d@quran-api-go", result.Start, result.Days, result.Unit, result.By, day.Day),
+			Date:        date,
+			Summary:     fmt.Sprintf(summary, day.Day, span),
+			Description: description,
+		}
+	}
+	return events
+}
+
+func firstNonZero(values ...int) int {
+	for _, v := range values {
+		if v != 0 {
+			return v
+		}
+	}
+	return 0
+}
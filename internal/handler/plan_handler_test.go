@@ -0,0 +1,245 @@
+package handler_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/plan"
+	"quran-api-go/internal/handler"
+)
+
+type MockPlanService struct {
+	KhatamFunc func(ctx context.Context, days int, unit plan.Unit, measure plan.Measure) ([]plan.Portion, error)
+}
+
+func (m *MockPlanService) Khatam(ctx context.Context, days int, unit plan.Unit, measure plan.Measure) ([]plan.Portion, error) {
+	if m.KhatamFunc != nil {
+		return m.KhatamFunc(ctx, days, unit, measure)
+	}
+	return nil, nil
+}
+
+// evenPortions gives each day the same number of ayahs of surah 2.
+func evenPortions(days int) []plan.Portion {
+	portions := make([]plan.Portion, days)
+	for i := range portions {
+		from := i*5 + 1
+		portions[i] = plan.Portion{
+			FromID: from + 7, ToID: from + 11,
+			FromSurah: 2, FromAyah: from, ToSurah: 2, ToAyah: from + 4,
+			Ayahs: 5, Words: 50, Letters: 200,
+		}
+	}
+	return portions
+}
+
+func setupPlanRouter(svc plan.PlanService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	h := handler.NewPlanHandler(svc, jakarta)
+	r := gin.New()
+	r.GET("/plans/khatam", h.Khatam)
+	r.GET("/plans/khatam.ics", h.KhatamICS)
+	return r
+}
+
+func TestPlanHandler_Khatam(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var gotDays int
+		var gotUnit plan.Unit
+		var gotMeasure plan.Measure
+		r := setupPlanRouter(&MockPlanService{
+			KhatamFunc: func(ctx context.Context, days int, unit plan.Unit, measure plan.Measure) ([]plan.Portion, error) {
+				gotDays, gotUnit, gotMeasure = days, unit, measure
+				return evenPortions(days), nil
+			},
+		})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/plans/khatam?days=15&start=2026-03-01&unit=page&by=words", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		if gotDays != 15 || gotUnit != plan.UnitPage || gotMeasure != plan.MeasureWords {
+			t.Errorf("service called with %d, %s, %s", gotDays, gotUnit, gotMeasure)
+		}
+		if cc := w.Header().Get("Cache-Control"); cc != "public, max-age=86400" {
+			t.Errorf("Cache-Control = %q", cc)
+		}
+
+		data := decodeData(t, w.Body.Bytes())
+		if data["start"] != "2026-03-01" || data["end"] != "2026-03-15" || data["unit"] != "page" || data["by"] != "words" {
+			t.Fatalf("unexpected plan: %v", data)
+		}
+		schedule := data["schedule"].([]any)
+		if len(schedule) != 15 {
+			t.Fatalf("expected 15 days, got %d", len(schedule))
+		}
+		day := schedule[1].(map[string]any)
+		if day["day"] != float64(2) || day["date"] != "2026-03-02" || day["from"] != "2:6" || day["to"] != "2:10" {
+			t.Errorf("unexpected day: %v", day)
+		}
+		if day["progress"] != float64(13.333) {
+			t.Errorf("progress = %v, want 13.333", day["progress"])
+		}
+	})
+
+	t.Run("Defaults", func(t *testing.T) {
+		r := setupPlanRouter(&MockPlanService{
+			KhatamFunc: func(ctx context.Context, days int, unit plan.Unit, measure plan.Measure) ([]plan.Portion, error) {
+				if days != 30 || unit != plan.UnitAyah || measure != plan.MeasureBalanced {
+					t.Errorf("service called with %d, %s, %s", days, unit, measure)
+				}
+				return evenPortions(days), nil
+			},
+		})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/plans/khatam", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", w.Code)
+		}
+		if cc := w.Header().Get("Cache-Control"); cc == "public, max-age=86400" {
+			t.Errorf("plan starting today cached for a full day: %q", cc)
+		}
+	})
+
+	t.Run("Ramadan preset", func(t *testing.T) {
+		r := setupPlanRouter(&MockPlanService{
+			KhatamFunc: func(ctx context.Context, days int, unit plan.Unit, measure plan.Measure) ([]plan.Portion, error) {
+				if days != 10 || unit != plan.UnitJuz {
+					t.Errorf("service called with %d, %s", days, unit)
+				}
+				return evenPortions(days), nil
+			},
+		})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/plans/khatam?preset=ramadan-last10&start=2027-02-28", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", w.Code)
+		}
+		data := decodeData(t, w.Body.Bytes())
+		if data["preset"] != "ramadan-last10" || data["end"] != "2027-03-09" {
+			t.Errorf("unexpected plan: %v", data)
+		}
+	})
+
+	t.Run("Ramadan 29 preset keeps whole juz", func(t *testing.T) {
+		r := setupPlanRouter(&MockPlanService{
+			KhatamFunc: func(ctx context.Context, days int, unit plan.Unit, measure plan.Measure) ([]plan.Portion, error) {
+				if days != 29 || unit != plan.UnitJuz {
+					t.Errorf("service called with %d, %s", days, unit)
+				}
+				return evenPortions(days), nil
+			},
+		})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/plans/khatam?preset=ramadan-29&start=2027-02-08", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", w.Code)
+		}
+	})
+
+	t.Run("iCalendar", func(t *testing.T) {
+		r := setupPlanRouter(&MockPlanService{
+			KhatamFunc: func(ctx context.Context, days int, unit plan.Unit, measure plan.Measure) ([]plan.Portion, error) {
+				return evenPortions(days), nil
+			},
+		})
+
+		for _, req := range []*http.Request{
+			httptest.NewRequest(http.MethodGet, "/plans/khatam.ics?days=3&start=2027-02-08&lang=id", nil),
+			func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/plans/khatam?days=3&start=2027-02-08&lang=id", nil)
+				req.Header.Set("Accept", "text/calendar")
+				return req
+			}(),
+		} {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Fatalf("%s: expected status 200, got %d", req.URL, w.Code)
+			}
+			if ct := w.Header().Get("Content-Type"); ct != "text/calendar; charset=utf-8" {
+				t.Errorf("%s: Content-Type = %q", req.URL, ct)
+			}
+			body := w.Body.String()
+			if strings.Count(body, "BEGIN:VEVENT") != 3 {
+				t.Errorf("%s: expected 3 events:\n%s", req.URL, body)
+			}
+			for _, want := range []string{
+				"X-WR-CALNAME:Khatam Al-Quran (3 hari)",
+				"DTSTART;VALUE=DATE:20270210",
+				"SUMMARY:Khatam hari ke-1: Al-Baqarah 1–5",
+			} {
+				if !strings.Contains(body, want) {
+					t.Errorf("%s: missing %q", req.URL, want)
+				}
+			}
+		}
+	})
+
+	t.Run("Invalid params", func(t *testing.T) {
+		r := setupPlanRouter(&MockPlanService{
+			KhatamFunc: func(ctx context.Context, days int, unit plan.Unit, measure plan.Measure) ([]plan.Portion, error) {
+				t.Fatal("service called for an invalid request")
+				return nil, nil
+			},
+		})
+
+		for _, q := range []string{"days=0", "days=31&unit=juz", "unit=surah", "by=time", "preset=syawal", "start=01-03-2026"} {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/plans/khatam?"+q, nil))
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("%s: expected status 400, got %d", q, w.Code)
+			}
+			if body := decodeBody(t, w.Body.Bytes()); body["code"] != "INVALID_PARAM" {
+				t.Errorf("%s: unexpected body %v", q, body)
+			}
+		}
+	})
+
+	t.Run("More days than the dataset divides into", func(t *testing.T) {
+		r := setupPlanRouter(&MockPlanService{
+			KhatamFunc: func(ctx context.Context, days int, unit plan.Unit, measure plan.Measure) ([]plan.Portion, error) {
+				return nil, domain.ErrInvalidRangeParam
+			},
+		})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/plans/khatam?days=30&unit=juz", nil))
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+		if body := decodeBody(t, w.Body.Bytes()); body["code"] != "INVALID_PARAM" {
+			t.Errorf("unexpected body %v", body)
+		}
+	})
+
+	t.Run("Dataset without divisions", func(t *testing.T) {
+		r := setupPlanRouter(&MockPlanService{
+			KhatamFunc: func(ctx context.Context, days int, unit plan.Unit, measure plan.Measure) ([]plan.Portion, error) {
+				return nil, fmt.Errorf("%w: page numbers", domain.ErrDatasetIncomplete)
+			},
+		})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/plans/khatam?unit=page", nil))
+		if w.Code != http.StatusServiceUnavailable {
+			t.Fatalf("expected status 503, got %d", w.Code)
+		}
+		if body := decodeBody(t, w.Body.Bytes()); body["code"] != "DATASET_INCOMPLETE" {
+			t.Errorf("unexpected body %v", body)
+		}
+	})
+}
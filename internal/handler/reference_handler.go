@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/pkg/reference"
+	"quran-api-go/pkg/response"
+)
+
+type ReferenceHandler struct {
+	ayahService  ayah.AyahService
+	surahService surah.SurahService
+}
+
+type ReferenceResponse struct {
+	Query   string            `json:"query"`
+	Results []ReferenceResult `json:"results"`
+}
+
+// ReferenceResult is one resolved span of a reference, e.g. "2:255-257".
+type ReferenceResult struct {
+	Reference string `json:"reference"`
+	SurahAyahsResponse
+}
+
+func NewReferenceHandler(ayahService ayah.AyahService, surahService surah.SurahService) *ReferenceHandler {
+	return &ReferenceHandler{ayahService: ayahService, surahService: surahService}
+}
+
+// Resolve godoc
+// @Summary     Resolve verse reference
+// @Description Parse a human-written verse reference and return its ayahs. Accepts numeric forms ("2:255", "QS 2:1-5"), comma lists ("2:255, 257"), cross-surah ranges ("2:286-3:5") and surah names in Indonesian or English spelling ("Al-Baqarah 255", "yasin 1-12").
+// @Tags        Ayah
+// @Produce     json
+// @Param       q     query    string  true   "Verse reference"
+// @Param       lang  query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
+// @Success     200   {object} response.SuccessResponse{data=ReferenceResponse}
+// @Failure     400   {object} response.ErrorResponse
+// @Failure     404   {object} response.ErrorResponse
+// @Failure     500   {object} response.ErrorResponse
+// @Router      /ref [get]
+func (h *ReferenceHandler) Resolve(c *gin.Context) {
+	var q struct {
+		Query string `query:"q" validate:"required" code:"INVALID_REFERENCE"`
+	}
+	if !bind(c, &q) {
+		return
+	}
+	query := q.Query
+	langs, err := requestLangs(c)
+	if err != nil {
+		badLang(c)
+		return
+	}
+	spans, err := reference.Parse(query)
+	var refErr *reference.Error
+	if errors.As(err, &refErr) {
+		response.BadRequestf(c, domain.CodeInvalidReference, response.Param("q"), refErr.Format, refErr.Args...)
+		return
+	}
+	if total := reference.Len(spans); total > reference.MaxAyahs {
+		response.BadRequestf(c, domain.CodeReferenceTooLarge, response.Details{"param": "q", "max": reference.MaxAyahs}, "reference covers %d ayahs, maximum is %d", total, reference.MaxAyahs)
+		return
+	}
+
+	results := make([]ReferenceResult, 0, len(spans))
+	for _, span := range spans {
+		sur, err := h.surahService.GetByID(c.Request.Context(), span.SurahID)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				response.NotFound(c, domain.CodeSurahNotFound, "surah not found")
+				return
+			}
+			response.InternalError(c)
+			return
+		}
+		if sur == nil {
+			response.NotFound(c, domain.CodeSurahNotFound, "surah not found")
+			return
+		}
+		ayahs, err := h.ayahService.GetBySurah(c.Request.Context(), span.SurahID, span.From, span.To)
+		if err != nil {
+			response.InternalError(c)
+			return
+		}
+		results = append(results, ReferenceResult{
+			Reference:          span.String(),
+			SurahAyahsResponse: newSurahAyahsResponse(*sur, ayahs, langs),
+		})
+	}
+
+	respond(c, ReferenceResponse{Query: query, Results: results}, h.surahService)
+}
@@ -0,0 +1,114 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/internal/handler"
+	"quran-api-go/internal/middleware"
+)
+
+func setupReferenceRouter(h *handler.ReferenceHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/ref", h.Resolve)
+	return r
+}
+
+func TestReferenceHandler_Resolve(t *testing.T) {
+	t.Run("Cross-surah range returns one result per surah", func(t *testing.T) {
+		var calls [][3]int
+		mockAyahService := &MockAyahService{
+			GetBySurahFunc: func(ctx context.Context, surahID, from, to int) ([]ayah.Ayah, error) {
+				calls = append(calls, [3]int{surahID, from, to})
+				return []ayah.Ayah{{ID: 1, SurahID: surahID, NumberInSurah: from, TranslationEn: "english"}}, nil
+			},
+		}
+		mockSurahService := &MockSurahService{
+			GetByIDFunc: func(ctx context.Context, id int) (*surah.Surah, error) {
+				return &surah.Surah{ID: id, Number: id, NameLatin: "name"}, nil
+			},
+		}
+
+		r := setupReferenceRouter(handler.NewReferenceHandler(mockAyahService, mockSurahService))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ref?q=2:286-3:5&lang=en", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		if len(calls) != 2 || calls[0] != [3]int{2, 286, 286} || calls[1] != [3]int{3, 1, 5} {
+			t.Fatalf("unexpected service calls: %v", calls)
+		}
+
+		data := decodeData(t, w.Body.Bytes())
+		results, ok := data["results"].([]any)
+		if !ok || len(results) != 2 {
+			t.Fatalf("expected 2 results, got %v", data["results"])
+		}
+		first := results[0].(map[string]any)
+		if first["reference"] != "2:286" {
+			t.Fatalf("expected reference 2:286, got %v", first["reference"])
+		}
+		ayahs := first["ayahs"].([]any)
+		if ayahs[0].(map[string]any)["translation"] != "english" {
+			t.Fatalf("expected English translation, got %v", ayahs[0])
+		}
+	})
+
+	t.Run("Missing query", func(t *testing.T) {
+		r := setupReferenceRouter(handler.NewReferenceHandler(&MockAyahService{}, &MockSurahService{}))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ref", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("Unknown surah name", func(t *testing.T) {
+		r := setupReferenceRouter(handler.NewReferenceHandler(&MockAyahService{}, &MockSurahService{}))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ref?q=xyzzy+1", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+		if body := decodeBody(t, w.Body.Bytes()); body["error"] != `invalid verse reference: unknown surah "xyzzy"` {
+			t.Fatalf("unexpected error: %v", body["error"])
+		}
+	})
+
+	t.Run("Parse errors are localised", func(t *testing.T) {
+		r := gin.New()
+		r.Use(middleware.Language())
+		r.GET("/ref", handler.NewReferenceHandler(&MockAyahService{}, &MockSurahService{}).Resolve)
+		req := httptest.NewRequest(http.MethodGet, "/ref?q=115:1", nil)
+		req.Header.Set("Accept-Language", "id")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+		if body := decodeBody(t, w.Body.Bytes()); body["error"] != `referensi ayat tidak valid: surah 115 tidak ada: "115:1"` {
+			t.Fatalf("expected indonesian error, got %v", body["error"])
+		}
+	})
+
+	t.Run("Too many ayahs", func(t *testing.T) {
+		r := setupReferenceRouter(handler.NewReferenceHandler(&MockAyahService{}, &MockSurahService{}))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ref?q=2-3", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+}
@@ -1,17 +1,17 @@
 package handler
 
 import (
-	"strconv"
-
 	"github.com/gin-gonic/gin"
 
 	"quran-api-go/internal/domain/search"
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/pkg/pagination"
 	"quran-api-go/pkg/response"
-	"quran-api-go/pkg/validator"
 )
 
 type SearchHandler struct {
-	service search.SearchService
+	service      search.SearchService
+	surahService surah.SurahService
 }
 
 type SearchResponse struct {
@@ -22,50 +22,60 @@ type SearchResponse struct {
 	Limit   int             `json:"limit"`
 }
 
-func NewSearchHandler(service search.SearchService) *SearchHandler {
-	return &SearchHandler{service: service}
+func NewSearchHandler(service search.SearchService, surahService surah.SurahService) *SearchHandler {
+	return &SearchHandler{service: service, surahService: surahService}
 }
 
 // Search godoc
 // @Summary     Search ayahs
 // @Description Full-text search across Quran ayahs (Arabic, Indonesian, English) using FTS5
 // @Tags        Search
-// @Produce     json
+// @Produce     json,text/csv,application/x-ndjson,text/markdown,text/plain
 // @Param       q         query    string  true   "Search query"
-// @Param       lang      query    string  false  "Translation language"  Enums(id, en)  default(id)
+// @Param       lang      query    string  false  "Translation language: id, en, a comma-separated list (id,en) or all"  default(id)
 // @Param       surah_id  query    int     false  "Filter by surah ID"  minimum(1)  maximum(114)
 // @Param       juz       query    int     false  "Filter by juz number"  minimum(1)  maximum(30)
 // @Param       page      query    int     false  "Page number"  minimum(1)  default(1)
 // @Param       limit     query    int     false  "Items per page"  minimum(1)  maximum(100)  default(20)
-// @Success     200       {object} response.SuccessResponse{data=SearchResponse}
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words, cite (see style)"
+// @Param       format   query    string  false  "Output format (overrides Accept)"  Enums(json, csv, ndjson, markdown, text)
+// @Success     200       {object} response.PageResponse{data=SearchResponse}
+// @Header      200       {string} Link "RFC 8288 links to the first, prev, next and last pages"
 // @Failure     400       {object} response.ErrorResponse
 // @Failure     500       {object} response.ErrorResponse
 // @Router      /search [get]
 func (h *SearchHandler) Search(c *gin.Context) {
-	query := c.Query("q")
-	if query == "" {
-		response.BadRequest(c, "query parameter 'q' is required")
+	var q struct {
+		Query   string `query:"q" validate:"required" code:"INVALID_SEARCH_QUERY"`
+		SurahID int    `query:"surah_id" validate:"min=1,max=114" code:"INVALID_SURAH_ID"`
+		Juz     int    `query:"juz" validate:"min=1,max=30" code:"INVALID_JUZ_NUMBER"`
+		pageQuery
+	}
+	if !bind(c, &q) {
 		return
 	}
+	query := q.Query
 
-	lang, err := validator.ValidateLang(c.Query("lang"))
+	langs, err := requestLangs(c)
 	if err != nil {
-		response.BadRequest(c, "lang must be 'id' or 'en'")
+		badLang(c)
 		return
 	}
 
-	surahID, _ := strconv.Atoi(c.Query("surah_id"))
-	juz, _ := strconv.Atoi(c.Query("juz"))
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	page := q.params()
 
 	params := search.Params{
 		Query:   query,
-		Lang:    lang,
-		SurahID: surahID,
-		Juz:     juz,
-		Page:    page,
-		Limit:   limit,
+		Lang:    langs[0],
+		SurahID: q.SurahID,
+		Juz:     q.Juz,
+		Page:    page.Page,
+		Limit:   page.Limit,
+	}
+
+	if len(langs) > 1 {
+		params.Langs = langs
 	}
 
 	results, total, err := h.service.Search(c.Request.Context(), params)
@@ -74,11 +84,28 @@ func (h *SearchHandler) Search(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, SearchResponse{
+	meta := pagination.NewMeta(page, total)
+	respondList(c, SearchResponse{
 		Query:   query,
 		Results: results,
 		Total:   total,
-		Page:    page,
-		Limit:   limit,
-	})
+		Page:    page.Page,
+		Limit:   page.Limit,
+	}, func() response.Table {
+		rows := make([]ayahRow, 0, len(results))
+		for _, item := range results {
+			rows = append(rows, ayahRow{
+				ID:            item.ID,
+				SurahID:       item.SurahID,
+				SurahName:     item.SurahInfo.NameLatin,
+				NumberInSurah: item.NumberInSurah,
+				TextUthmani:   item.TextUthmani,
+				Translation:   item.Translation,
+				Translations:  item.Translations,
+				Juz:           item.JuzNumber,
+				Item:          item,
+			})
+		}
+		return newAyahTable(langs, rows)
+	}, &meta, h.surahService)
 }
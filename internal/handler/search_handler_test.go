@@ -26,7 +26,7 @@ func TestSearchHandler_ResponseIncludesQuery(t *testing.T) {
 	r := gin.New()
 
 	svc := &mockSearchService{}
-	h := handler.NewSearchHandler(svc)
+	h := handler.NewSearchHandler(svc, &MockSurahService{})
 	r.GET("/search", h.Search)
 
 	w := httptest.NewRecorder()
@@ -71,6 +71,71 @@ func TestSearchHandler_ResponseIncludesQuery(t *testing.T) {
 	}
 }
 
+func TestSearchHandler_PaginationMetaAndLink(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := handler.NewSearchHandler(&mockSearchService{}, &MockSurahService{})
+	r.GET("/search", h.Search)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/search?q=test&page=2&limit=20", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+
+	var body struct {
+		Meta struct {
+			Page       int  `json:"page"`
+			Total      int  `json:"total"`
+			TotalPages int  `json:"total_pages"`
+			Next       *int `json:"next"`
+			Prev       *int `json:"prev"`
+		} `json:"meta"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if body.Meta.Page != 2 || body.Meta.Total != 100 || body.Meta.TotalPages != 5 {
+		t.Fatalf("unexpected meta: %+v", body.Meta)
+	}
+	if body.Meta.Next == nil || *body.Meta.Next != 3 || body.Meta.Prev == nil || *body.Meta.Prev != 1 {
+		t.Fatalf("unexpected next/prev: %+v", body.Meta)
+	}
+
+	want := `</search?limit=20&page=1&q=test>; rel="first", </search?limit=20&page=1&q=test>; rel="prev", </search?limit=20&page=3&q=test>; rel="next", </search?limit=20&page=5&q=test>; rel="last"`
+	if got := w.Header().Get("Link"); got != want {
+		t.Fatalf("unexpected Link header:\n got %s\nwant %s", got, want)
+	}
+}
+
+func TestSearchHandler_ClampsLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := handler.NewSearchHandler(&mockSearchService{}, &MockSurahService{})
+	r.GET("/search", h.Search)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/search?q=test&limit=500", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	var body struct {
+		Meta struct {
+			Limit int `json:"limit"`
+		} `json:"meta"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if body.Meta.Limit != 100 {
+		t.Fatalf("expected limit clamped to 100, got %d", body.Meta.Limit)
+	}
+}
+
 func getKeys(m map[string]any) []string {
 	keys := make([]string, 0, len(m))
 	for k := range m {
@@ -78,3 +143,36 @@ func getKeys(m map[string]any) []string {
 	}
 	return keys
 }
+
+func TestSearchHandler_InvalidParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := handler.NewSearchHandler(&mockSearchService{}, &MockSurahService{})
+	r.GET("/search", h.Search)
+
+	tests := []struct {
+		query, code string
+	}{
+		{"", "INVALID_SEARCH_QUERY"},
+		{"q=test&page=abc", "INVALID_PAGINATION"},
+		{"q=test&page=0", "INVALID_PAGINATION"},
+		{"q=test&limit=0", "INVALID_PAGINATION"},
+		{"q=test&surah_id=x", "INVALID_SURAH_ID"},
+		{"q=test&juz=31", "INVALID_JUZ_NUMBER"},
+	}
+	for _, tc := range tests {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/search?"+tc.query, nil))
+
+		var body struct {
+			Code string `json:"code"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if w.Code != http.StatusBadRequest || body.Code != tc.code {
+			t.Errorf("%s: got %d %s, want 400 %s", tc.query, w.Code, body.Code, tc.code)
+		}
+	}
+}
@@ -0,0 +1,460 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/pkg/arabic"
+	"quran-api-go/pkg/citation"
+	"quran-api-go/pkg/pagination"
+	"quran-api-go/pkg/reference"
+	"quran-api-go/pkg/response"
+	"quran-api-go/pkg/validator"
+)
+
+// Includes are optional expansions requested with ?include=.
+const (
+	includeSurah  = "surah"  // full surah object next to every surah_id
+	includeWords  = "words"  // text_uthmani split into words
+	includeCite   = "cite"   // citation in ?style=, see pkg/citation
+	includeTafsir = "tafsir" // not in the dataset yet
+	includeAudio  = "audio"  // not in the dataset yet
+)
+
+var availableIncludes = map[string]bool{
+	includeSurah:  true,
+	includeWords:  true,
+	includeCite:   true,
+	includeTafsir: false,
+	includeAudio:  false,
+}
+
+// includeKeys lists, for each available include, the JSON keys expand
+// attaches it next to.
+var includeKeys = map[string][]string{
+	includeSurah: {"surah_id", "surah"},
+	includeWords: {"text_uthmani"},
+	includeCite:  {"number_in_surah"},
+}
+
+// enveloped is implemented by responses that wrap their items in headers or
+// pagination. itemsPaths lists where the items sit as dot-separated JSON
+// keys; a list on the way stands for each of its elements.
+type enveloped interface {
+	itemsPaths() []string
+}
+
+func (SurahAyahsResponse) itemsPaths() []string  { return []string{"ayahs"} }
+func (JuzAyahsResponse) itemsPaths() []string    { return []string{"ayahs"} }
+func (AyahListResponse) itemsPaths() []string    { return []string{"ayahs"} }
+func (AyahRangeResponse) itemsPaths() []string   { return []string{"items.ayah"} }
+func (AyahContextResponse) itemsPaths() []string { return []string{"ayah", "before", "after"} }
+func (ReferenceResponse) itemsPaths() []string   { return []string{"results.ayahs"} }
+func (SearchResponse) itemsPaths() []string      { return []string{"results"} }
+func (DailyResponse) itemsPaths() []string       { return []string{"ayah"} }
+func (KhatamResponse) itemsPaths() []string      { return []string{"schedule"} }
+
+// shape is the parsed ?fields= and ?include= of a request.
+type shape struct {
+	fields  map[string]struct{}
+	include map[string]struct{}
+
+	citeStyle citation.Style
+	citeLang  string
+}
+
+func parseShape(c *gin.Context) (shape, error) {
+	var s shape
+
+	for _, field := range splitList(c.Query("fields")) {
+		for _, r := range field {
+			if r != '_' && !unicode.IsLower(r) && !unicode.IsDigit(r) {
+				return shape{}, paramErrorf(domain.CodeInvalidFields, response.Details{"param": "fields", "value": field}, "invalid field %q", field)
+			}
+		}
+		if s.fields == nil {
+			s.fields = map[string]struct{}{}
+		}
+		s.fields[field] = struct{}{}
+	}
+
+	for _, name := range splitList(c.Query("include")) {
+		available, known := availableIncludes[name]
+		if !known {
+			return shape{}, paramErrorf(
+				domain.CodeInvalidInclude,
+				response.OneOf("include", includeSurah, includeWords, includeCite, includeTafsir, includeAudio),
+				"include must be a comma-separated list of %s, %s, %s, %s or %s", includeSurah, includeWords, includeCite, includeTafsir, includeAudio,
+			)
+		}
+		if !available {
+			return shape{}, paramErrorf(domain.CodeInvalidInclude, response.Details{"param": "include", "value": name}, "include %q is not available in this dataset", name)
+		}
+		if s.include == nil {
+			s.include = map[string]struct{}{}
+		}
+		s.include[name] = struct{}{}
+	}
+
+	if s.includes(includeCite) {
+		style, err := citation.ParseStyle(c.Query("style"))
+		if err != nil {
+			return shape{}, paramErrorf(domain.CodeInvalidCitationStyle, styleDetails(), invalidStyleMessage)
+		}
+		s.citeStyle = style
+		s.citeLang = "id"
+		if langs, err := validator.ValidateLangs(c.Query("lang")); err == nil && c.Query("lang") != "" {
+			s.citeLang = langs[0]
+		} else if lang := c.GetString(response.LangKey); lang != "" {
+			s.citeLang = lang
+		}
+	}
+
+	return s, nil
+}
+
+func (s shape) empty() bool {
+	return len(s.fields) == 0 && len(s.include) == 0
+}
+
+func (s shape) includes(name string) bool {
+	_, ok := s.include[name]
+	return ok
+}
+
+// respond writes data with response.Success after applying the request's
+// ?include= expansions and ?fields= selection. surahs backs include=surah and
+// may be nil for handlers without surah data.
+//
+// fields applies to the items of data: the elements of a list, the items of
+// an enveloped response, or else data itself. Envelopes (pagination, surah
+// headers) are kept, so fields=text_uthmani on a juz returns every ayah as
+// Arabic text only. A field the items do not have, or an include data has
+// nowhere to go, is a 400.
+//
+// Shaped data is re-encoded from a map, so its keys come out in
+// alphabetical order rather than in struct order.
+func respond(c *gin.Context, data any, surahs surah.SurahService) {
+	if shaped, ok := shapeData(c, data, surahs); ok {
+		response.Success(c, shaped)
+	}
+}
+
+// respondPage is respond for paginated lists, written with response.Page.
+func respondPage(c *gin.Context, data any, meta pagination.Meta, surahs surah.SurahService) {
+	if shaped, ok := shapeData(c, data, surahs); ok {
+		response.Page(c, shaped, meta)
+	}
+}
+
+// shapeData applies ?include= and ?fields= to data. On failure it writes the
+// error response and reports false.
+func shapeData(c *gin.Context, data any, surahs surah.SurahService) (any, bool) {
+	s, err := parseShape(c)
+	if err != nil {
+		badRequest(c, err)
+		return nil, false
+	}
+	if s.empty() {
+		return data, true
+	}
+	paths := itemPaths(data)
+	if err := checkFields(data, paths, s); err != nil {
+		badRequest(c, err)
+		return nil, false
+	}
+	if err := checkIncludes(data, s, surahs); err != nil {
+		badRequest(c, err)
+		return nil, false
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		response.InternalError(c)
+		return nil, false
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var tree any
+	if err := dec.Decode(&tree); err != nil {
+		response.InternalError(c)
+		return nil, false
+	}
+
+	var surahByID map[string]surah.Surah
+	if s.includes(includeSurah) && surahs != nil {
+		all, err := surahs.GetAll(c.Request.Context())
+		if err != nil {
+			response.InternalError(c)
+			return nil, false
+		}
+		surahByID = make(map[string]surah.Surah, len(all))
+		for _, item := range all {
+			surahByID[fmt.Sprint(item.ID)] = item
+		}
+	}
+
+	tree = expand(tree, s, surahByID)
+	if len(s.fields) > 0 {
+		selectFields(tree, paths, s.fields)
+	}
+	return tree, true
+}
+
+// expand adds the requested includes to every object in v.
+func expand(v any, s shape, surahByID map[string]surah.Surah) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, child := range t {
+			t[k] = expand(child, s, surahByID)
+		}
+		if surahByID != nil {
+			if header, ok := t["surah"].(map[string]any); ok {
+				if full, ok := surahByID[fmt.Sprint(header["id"])]; ok {
+					t["surah"] = full
+				}
+			} else if id, ok := t["surah_id"]; ok {
+				if full, ok := surahByID[fmt.Sprint(id)]; ok {
+					t["surah"] = full
+				}
+			}
+		}
+		if s.includes(includeCite) {
+			if cite, ok := citeFor(t, s); ok {
+				t["cite"] = cite
+			}
+		}
+		if s.includes(includeWords) {
+			if text, ok := t["text_uthmani"].(string); ok {
+				t["words"] = arabic.Words(text)
+			}
+		}
+	case []any:
+		for i, child := range t {
+			t[i] = expand(child, s, surahByID)
+		}
+	}
+	return v
+}
+
+// citeFor cites an ayah object located by surah_id and number_in_surah, or
+// by its global number when the surah is implied by an enclosing object.
+func citeFor(obj map[string]any, s shape) (string, bool) {
+	number, ok := jsonInt(obj["number_in_surah"])
+	if !ok {
+		return "", false
+	}
+	surahID, ok := jsonInt(obj["surah_id"])
+	if !ok {
+		global, ok := jsonInt(obj["number"])
+		if !ok {
+			return "", false
+		}
+		if surahID, _, ok = reference.Locate(global); !ok {
+			return "", false
+		}
+	}
+	cite, err := citation.Format(s.citeStyle, s.citeLang, reference.Span{SurahID: surahID, From: number, To: number})
+	return cite, err == nil
+}
+
+func jsonInt(v any) (int, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	i, err := n.Int64()
+	return int(i), err == nil
+}
+
+// itemPaths splits the itemsPaths of an enveloped data into keys. Other
+// data is its own item.
+func itemPaths(data any) [][]string {
+	env, ok := data.(enveloped)
+	if !ok {
+		return [][]string{nil}
+	}
+	var paths [][]string
+	for _, path := range env.itemsPaths() {
+		paths = append(paths, strings.Split(path, "."))
+	}
+	return paths
+}
+
+// checkFields reports the first requested field that none of the items of
+// data has, judging by their Go types so that omitempty fields count.
+func checkFields(data any, paths [][]string, s shape) error {
+	if len(s.fields) == 0 || data == nil {
+		return nil
+	}
+	known := map[string]struct{}{}
+	for _, keys := range paths {
+		fields := itemFields(reflect.TypeOf(data), keys)
+		if fields == nil {
+			return nil // items without a fixed shape
+		}
+		maps.Copy(known, fields)
+	}
+	for name := range s.include {
+		known[name] = struct{}{}
+	}
+	for _, field := range slices.Sorted(maps.Keys(s.fields)) {
+		if _, ok := known[field]; !ok {
+			return paramErrorf(domain.CodeInvalidParam, response.Details{"param": "fields", "value": field}, "unknown field %q", field)
+		}
+	}
+	return nil
+}
+
+// checkIncludes reports the first requested include that data has nowhere
+// to go, judging by its Go type: include=surah on a juz, say.
+func checkIncludes(data any, s shape, surahs surah.SurahService) error {
+	if len(s.include) == 0 || data == nil {
+		return nil
+	}
+	keys := map[string]struct{}{}
+	if !typeKeys(reflect.TypeOf(data), keys, map[reflect.Type]bool{}) {
+		return nil // data without a fixed shape
+	}
+	for _, name := range slices.Sorted(maps.Keys(s.include)) {
+		applies := slices.ContainsFunc(includeKeys[name], func(key string) bool {
+			_, ok := keys[key]
+			return ok
+		})
+		if !applies || (name == includeSurah && surahs == nil) {
+			return paramErrorf(domain.CodeInvalidInclude, response.Details{"param": "include", "value": name}, "include %q does not apply to this endpoint", name)
+		}
+	}
+	return nil
+}
+
+// typeKeys adds the JSON keys of t, at any depth, to keys. It reports false
+// when part of t has no fixed shape.
+func typeKeys(t reflect.Type, keys map[string]struct{}, seen map[reflect.Type]bool) bool {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Struct:
+		if seen[t] {
+			return true
+		}
+		seen[t] = true
+		for name, typ := range jsonFields(t) {
+			keys[name] = struct{}{}
+			if !typeKeys(typ, keys, seen) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// itemFields returns the JSON keys of the items reached from t through
+// keys, or nil when they are not structs.
+func itemFields(t reflect.Type, keys []string) map[string]struct{} {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	fields := jsonFields(t)
+	if len(keys) == 0 {
+		names := make(map[string]struct{}, len(fields))
+		for name := range fields {
+			names[name] = struct{}{}
+		}
+		return names
+	}
+	child, ok := fields[keys[0]]
+	if !ok {
+		return nil
+	}
+	return itemFields(child, keys[1:])
+}
+
+// jsonFields maps the JSON keys of struct type t to their types, flattening
+// embedded structs as encoding/json does.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" || (!f.IsExported() && !f.Anonymous) {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			embedded := f.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for name, typ := range jsonFields(embedded) {
+					if _, ok := fields[name]; !ok {
+						fields[name] = typ
+					}
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// selectFields keeps only the named fields in each item of v, found along
+// paths (see itemPaths).
+func selectFields(v any, paths [][]string, fields map[string]struct{}) {
+	for _, keys := range paths {
+		eachItem(v, keys, func(item map[string]any) {
+			for k := range item {
+				if _, ok := fields[k]; !ok {
+					delete(item, k)
+				}
+			}
+		})
+	}
+}
+
+// eachItem calls fn for every object reached from v through keys, stepping
+// into each element of a list on the way.
+func eachItem(v any, keys []string, fn func(map[string]any)) {
+	switch t := v.(type) {
+	case []any:
+		for _, child := range t {
+			eachItem(child, keys, fn)
+		}
+	case map[string]any:
+		if len(keys) == 0 {
+			fn(t)
+			return
+		}
+		eachItem(t[keys[0]], keys[1:], fn)
+	}
+}
+
+func splitList(raw string) []string {
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
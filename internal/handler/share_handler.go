@@ -0,0 +1,302 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/pkg/citation"
+	"quran-api-go/pkg/reference"
+	"quran-api-go/pkg/response"
+	"quran-api-go/pkg/validator"
+)
+
+const (
+	shareProviderName = "Quran API Go"
+	// oembedWidth is the default width of the rich snippet; consumers may
+	// ask for less with ?maxwidth=.
+	oembedWidth = 550
+	// shareDescriptionRunes keeps og:description within what chat apps show.
+	shareDescriptionRunes = 280
+)
+
+// shareTemplate is the page behind a shared /s/:surah/:ayah link. Crawlers
+// read the meta tags; people following the link see the ayah itself.
+var shareTemplate = template.Must(template.New("share").Parse(`<!doctype html>
+<html lang="{{.Lang}}">
+  <head>
+    <meta charset="utf-8" />
+    <meta name="viewport" content="width=device-width, initial-scale=1" />
+    <title>{{.Title}}</title>
+    <meta name="description" content="{{.Description}}" />
+    <link rel="canonical" href="{{.URL}}" />
+    <link rel="alternate" type="application/json+oembed" href="{{.OEmbedURL}}" title="{{.Title}}" />
+    <meta property="og:type" content="article" />
+    <meta property="og:site_name" content="{{.Provider}}" />
+    <meta property="og:title" content="{{.Title}}" />
+    <meta property="og:description" content="{{.Description}}" />
+    <meta property="og:url" content="{{.URL}}" />
+    <meta name="twitter:card" content="summary" />
+    <meta name="twitter:title" content="{{.Title}}" />
+    <meta name="twitter:description" content="{{.Description}}" />
+    <style>
+      body { margin: 0; padding: 2rem 1rem; font-family: system-ui, sans-serif; background: #fdfcf8; color: #1c1c1c; }
+      main { max-width: 40rem; margin: 0 auto; }
+      .ar { font-family: "Amiri", "Scheherazade New", serif; font-size: 2rem; line-height: 2; text-align: right; }
+      cite { color: #0f766e; font-style: normal; font-weight: 600; }
+    </style>
+  </head>
+  <body>
+    <main>
+      {{.Snippet}}
+      <p><a href="{{.JSONURL}}">JSON</a></p>
+    </main>
+  </body>
+</html>
+`))
+
+// snippetTemplate is the markup shared by the page and the oEmbed response.
+var snippetTemplate = template.Must(template.New("snippet").Parse(`<blockquote class="quran-ayah" cite="{{.URL}}">` +
+	`<p class="ar" lang="ar" dir="rtl">{{.Arabic}}</p>` +
+	`<p lang="{{.Lang}}">{{.Translation}}</p>` +
+	`<footer><cite><a href="{{.URL}}">{{.Title}}</a></cite></footer>` +
+	`</blockquote>`))
+
+type sharePage struct {
+	Lang        string
+	Title       string
+	Description string
+	Arabic      string
+	Translation string
+	Provider    string
+	URL         string
+	JSONURL     string
+	OEmbedURL   string
+	Snippet     template.HTML
+}
+
+// OEmbedResponse is an oEmbed 1.0 "rich" response.
+type OEmbedResponse struct {
+	Version      string `json:"version"`
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	ProviderName string `json:"provider_name"`
+	ProviderURL  string `json:"provider_url"`
+	HTML         string `json:"html"`
+	Width        int    `json:"width"`
+	Height       *int   `json:"height"`
+}
+
+type ShareHandler struct {
+	ayahService ayah.AyahService
+}
+
+func NewShareHandler(ayahService ayah.AyahService) *ShareHandler {
+	return &ShareHandler{ayahService: ayahService}
+}
+
+// Page godoc
+// @Summary     Shareable ayah page
+// @Description Server-rendered HTML page for an ayah with OpenGraph and Twitter summary meta tags, so shared links unfurl with the ayah text and translation. Advertises the oEmbed endpoint.
+// @Tags        Share
+// @Produce     html
+// @Param       surah  path     int     true   "Surah ID (1-114)"  minimum(1)  maximum(114)
+// @Param       ayah   path     int     true   "Ayah number in surah"
+// @Param       lang   query    string  false  "Translation language: id or en"  default(id)
+// @Success     200    {string} string  "HTML page"
+// @Failure     400    {object} response.ErrorResponse
+// @Failure     404    {object} response.ErrorResponse
+// @Failure     500    {object} response.ErrorResponse
+// @Router      /s/{surah}/{ayah} [get]
+func (h *ShareHandler) Page(c *gin.Context) {
+	lang, ok := shareLang(c, "")
+	if !ok {
+		return
+	}
+	page, ok := h.load(c, c, lang)
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := shareTemplate.Execute(&buf, page); err != nil {
+		response.InternalError(c)
+		return
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", buf.Bytes())
+}
+
+// OEmbed godoc
+// @Summary     oEmbed for ayah links
+// @Description oEmbed 1.0 provider for /s/{surah}/{ayah} links. Returns a "rich" response whose html is a self-contained blockquote. Only the JSON format is supported.
+// @Tags        Share
+// @Produce     json
+// @Param       url       query    string  true   "Shared ayah URL on this host, e.g. https://quran.api.digitalislami.id/s/2/255"
+// @Param       maxwidth  query    int     false  "Maximum width in pixels"
+// @Param       format    query    string  false  "Response format"  Enums(json)
+// @Success     200       {object} OEmbedResponse
+// @Failure     400       {object} response.ErrorResponse
+// @Failure     404       {object} response.ErrorResponse
+// @Failure     501       {object} response.ErrorResponse
+// @Router      /oembed [get]
+func (h *ShareHandler) OEmbed(c *gin.Context) {
+	if format := c.Query("format"); format != "" && format != "json" {
+		response.NotImplemented(c, "only the json format is supported", response.OneOf("format", "json"))
+		return
+	}
+	var q struct {
+		URL      string `query:"url" validate:"required"`
+		MaxWidth int    `query:"maxwidth" validate:"min=1"`
+	}
+	if !bind(c, &q) {
+		return
+	}
+	width := oembedWidth
+	if q.MaxWidth != 0 {
+		width = min(width, q.MaxWidth)
+	}
+
+	target, err := url.Parse(q.URL)
+	if err != nil {
+		response.BadRequest(c, domain.CodeInvalidParam, "invalid url", response.Param("url"))
+		return
+	}
+	// Only links to this deployment are ours to embed.
+	base, _ := url.Parse(resolveDocsBaseURL(c))
+	parts := strings.Split(strings.Trim(target.Path, "/"), "/")
+	if target.Host != base.Host || len(parts) != 3 || parts[0] != "s" {
+		response.NotFound(c, domain.CodeShareNotFound, "url is not a shared ayah link", response.Param("url"))
+		return
+	}
+	// The shared link's own ?lang= wins over the consumer's preferences.
+	lang, ok := shareLang(c, target.Query().Get("lang"))
+	if !ok {
+		return
+	}
+
+	page, ok := h.load(c, pathParams{"surah": parts[1], "ayah": parts[2]}, lang)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, OEmbedResponse{
+		Version:      "1.0",
+		Type:         "rich",
+		Title:        page.Title,
+		ProviderName: page.Provider,
+		ProviderURL:  resolveDocsBaseURL(c),
+		HTML:         string(page.Snippet),
+		Width:        width,
+	})
+}
+
+// shareLang picks the single page language: override when set, otherwise
+// the request's first language. On failure it writes the error response and
+// reports false.
+func shareLang(c *gin.Context, override string) (string, bool) {
+	if override != "" {
+		langs, err := validator.ValidateLangs(override)
+		if err != nil {
+			badLang(c)
+			return "", false
+		}
+		return langs[0], true
+	}
+	langs, err := requestLangs(c)
+	if err != nil {
+		badLang(c)
+		return "", false
+	}
+	return langs[0], true
+}
+
+// pathParams serves the :surah and :ayah of a share link parsed from a URL,
+// so validator.Bind can check them as it does for a request.
+type pathParams map[string]string
+
+func (p pathParams) Param(key string) string { return p[key] }
+
+func (p pathParams) GetQuery(string) (string, bool) { return "", false }
+
+// load fetches the ayah behind a share link, whose :surah and :ayah src
+// provides, and builds its page. On failure it writes the error response
+// and reports false.
+func (h *ShareHandler) load(c *gin.Context, src validator.Source, lang string) (sharePage, bool) {
+	var p struct {
+		Surah int `path:"surah" validate:"required,min=1,max=114" code:"INVALID_SURAH_ID"`
+		Ayah  int `path:"ayah" validate:"required,min=1" code:"INVALID_AYAH_NUMBER"`
+	}
+	if err := validator.Bind(src, &p); err != nil {
+		badRequest(c, err)
+		return sharePage{}, false
+	}
+	surahID, number := p.Surah, p.Ayah
+	if count := reference.AyahCount(surahID); number > count {
+		badRequest(c, validator.RangeError("ayah", domain.CodeInvalidAyahNumber, 1, count))
+		return sharePage{}, false
+	}
+	ay, err := h.ayahService.GetBySurahAndNumber(c.Request.Context(), surahID, number)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
+			return sharePage{}, false
+		}
+		response.InternalError(c)
+		return sharePage{}, false
+	}
+	if ay == nil {
+		response.NotFound(c, domain.CodeAyahNotFound, "ayah not found")
+		return sharePage{}, false
+	}
+
+	title, err := citation.Format(citation.StyleKemenag, lang, reference.Span{SurahID: surahID, From: number, To: number})
+	if err != nil {
+		response.InternalError(c)
+		return sharePage{}, false
+	}
+	translation, _ := translationByLang(*ay, []string{lang})
+	base := resolveDocsBaseURL(c)
+	key := strconv.Itoa(surahID) + "/" + strconv.Itoa(number)
+	query := "?lang=" + lang
+
+	page := sharePage{
+		Lang:        lang,
+		Title:       title,
+		Description: truncateRunes(translation, shareDescriptionRunes),
+		Arabic:      ay.TextUthmani,
+		Translation: translation,
+		Provider:    shareProviderName,
+		URL:         base + "/s/" + key + query,
+		JSONURL:     base + "/v1/surah/" + strconv.Itoa(surahID) + "/ayah/" + strconv.Itoa(number) + query,
+	}
+	page.OEmbedURL = base + "/oembed?url=" + url.QueryEscape(page.URL)
+
+	var snippet bytes.Buffer
+	if err := snippetTemplate.Execute(&snippet, page); err != nil {
+		response.InternalError(c)
+		return sharePage{}, false
+	}
+	page.Snippet = template.HTML(snippet.String())
+	return page, true
+}
+
+// truncateRunes shortens s to at most n runes, cutting at a space and
+// adding an ellipsis.
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	cut := string(runes[:n-1])
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+	return cut + "…"
+}
@@ -0,0 +1,137 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/ayah"
+	"quran-api-go/internal/handler"
+)
+
+func setupShareRouter() *gin.Engine {
+	mockAyahService := &MockAyahService{
+		GetBySurahAndNumberFunc: func(ctx context.Context, surahID, number int) (*ayah.Ayah, error) {
+			if surahID != 1 || number != 1 {
+				return nil, domain.ErrNotFound
+			}
+			return &ayah.Ayah{
+				ID:             1,
+				SurahID:        1,
+				NumberInSurah:  1,
+				TextUthmani:    "بِسْمِ ٱللَّهِ ٱلرَّحْمَٰنِ ٱلرَّحِيمِ",
+				TranslationIdo: "Dengan nama Allah Yang Maha Pengasih, Maha Penyayang.",
+				TranslationEn:  "In the name of Allah, the Entirely Merciful, the Especially Merciful.",
+			}, nil
+		},
+	}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	h := handler.NewShareHandler(mockAyahService)
+	r.GET("/s/:surah/:ayah", h.Page)
+	r.GET("/oembed", h.OEmbed)
+	return r
+}
+
+func TestShareHandler_Page(t *testing.T) {
+	r := setupShareRouter()
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/s/1/1?lang=en", nil)
+	req.Host = "example.com"
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Fatalf("expected HTML, got %q", ct)
+	}
+	body := w.Body.String()
+	for _, want := range []string{
+		`<meta property="og:title" content="QS. Al-Fatiha [1]: 1" />`,
+		`<meta property="og:description" content="In the name of Allah, the Entirely Merciful, the Especially Merciful." />`,
+		`<meta name="twitter:card" content="summary" />`,
+		`type="application/json+oembed" href="http://example.com/oembed?url=http%3A%2F%2Fexample.com%2Fs%2F1%2F1%3Flang%3Den"`,
+		`dir="rtl">بِسْمِ`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected page to contain %s", want)
+		}
+	}
+
+	if strings.Contains(body, "og:image") {
+		t.Error("expected no og:image, chat apps do not render SVG cards")
+	}
+
+	tests := []struct {
+		url        string
+		wantStatus int
+	}{
+		{"/s/0/1", http.StatusBadRequest},
+		{"/s/1/8", http.StatusBadRequest},
+		{"/s/1/x", http.StatusBadRequest},
+		{"/s/2/1", http.StatusNotFound},
+	}
+	for _, tc := range tests {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.url, nil))
+		if w.Code != tc.wantStatus {
+			t.Errorf("%s: expected status %d, got %d", tc.url, tc.wantStatus, w.Code)
+		}
+	}
+}
+
+func TestShareHandler_OEmbed(t *testing.T) {
+	r := setupShareRouter()
+
+	t.Run("Rich response", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		target := url.QueryEscape("http://example.com/s/1/1?lang=en")
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/oembed?maxwidth=400&url="+target, nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		var got handler.OEmbedResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if got.Version != "1.0" || got.Type != "rich" || got.Width != 400 {
+			t.Fatalf("unexpected oEmbed response: %+v", got)
+		}
+		if got.Title != "QS. Al-Fatiha [1]: 1" || !strings.Contains(got.HTML, "the Entirely Merciful") {
+			t.Fatalf("expected English snippet, got %+v", got)
+		}
+	})
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+	}{
+		{"Missing url", "/oembed", http.StatusBadRequest},
+		{"Not a share link", "/oembed?url=" + url.QueryEscape("http://example.com/surah/1"), http.StatusNotFound},
+		{"Foreign host", "/oembed?url=" + url.QueryEscape("http://evil.example/s/1/1"), http.StatusNotFound},
+		{"Relative url", "/oembed?url=" + url.QueryEscape("/s/1/1"), http.StatusNotFound},
+		{"Unknown ayah", "/oembed?url=" + url.QueryEscape("http://example.com/s/2/1"), http.StatusNotFound},
+		{"XML format", "/oembed?format=xml&url=" + url.QueryEscape("http://example.com/s/1/1"), http.StatusNotImplemented},
+		{"Invalid maxwidth", "/oembed?maxwidth=0&url=" + url.QueryEscape("http://example.com/s/1/1"), http.StatusBadRequest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.url, nil))
+			if w.Code != tc.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
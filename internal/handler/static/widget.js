@@ -0,0 +1,166 @@
+/*
+ * Quran API Go embeddable ayah widget.
+ *
+ *   <script src="https://quran.api.digitalislami.id/static/widget.js" defer></script>
+ *   <div data-quran-ref="2:255"></div>
+ *   <div data-quran-ref="Al-Kahf 1-5" data-quran-theme="dark" data-quran-lang="en"></div>
+ *
+ * Element attributes:
+ *   data-quran-ref    any reference accepted by GET /v1/ref (required)
+ *   data-quran-theme  light (default), dark, sepia or auto (follows the OS)
+ *   data-quran-lang   id (default), en, or a list such as id,en
+ *
+ * Attributes on the script tag set page-wide defaults: data-theme, data-lang
+ * and data-api (API origin, defaults to where this script is served from).
+ *
+ * The widget calls the API from the embedding page, so that page's origin
+ * must be allowed by the server's ALLOWED_ORIGINS setting.
+ *
+ * Content added later can be rendered with window.QuranWidget.render(root).
+ */
+(function () {
+  "use strict";
+
+  var script = document.currentScript;
+  var defaults = {
+    api: (script && script.getAttribute("data-api")) || (script ? new URL(script.src).origin : ""),
+    theme: (script && script.getAttribute("data-theme")) || "light",
+    lang: (script && script.getAttribute("data-lang")) || "id",
+  };
+  var themes = ["light", "dark", "sepia", "auto"];
+
+  var css =
+    ".quran-widget{--qw-bg:#fdfcf8;--qw-fg:#1c1c1c;--qw-muted:#555;--qw-accent:#0f766e;" +
+    "box-sizing:border-box;margin:1em 0;padding:1.25em 1.5em;border:1px solid var(--qw-accent);border-radius:12px;" +
+    "background:var(--qw-bg);color:var(--qw-fg);font:16px/1.6 system-ui,-apple-system,'Segoe UI',Roboto,sans-serif}" +
+    ".quran-widget--dark{--qw-bg:#0f172a;--qw-fg:#f8fafc;--qw-muted:#cbd5e1;--qw-accent:#5eead4}" +
+    ".quran-widget--sepia{--qw-bg:#f4ecd8;--qw-fg:#3b2f1e;--qw-muted:#6b5a43;--qw-accent:#8b5e34}" +
+    "@media (prefers-color-scheme:dark){.quran-widget--auto{--qw-bg:#0f172a;--qw-fg:#f8fafc;--qw-muted:#cbd5e1;--qw-accent:#5eead4}}" +
+    ".quran-widget__ayah+.quran-widget__ayah{margin-top:1em;padding-top:1em;border-top:1px solid color-mix(in srgb,var(--qw-accent) 30%,transparent)}" +
+    ".quran-widget__arabic{margin:0 0 .5em;font:1.75em/2 'Amiri','Scheherazade New','Noto Naskh Arabic',serif;text-align:right}" +
+    ".quran-widget__number{color:var(--qw-accent)}" +
+    ".quran-widget__translation{margin:0;color:var(--qw-muted)}" +
+    ".quran-widget__cite{display:block;margin-top:1em;color:var(--qw-accent);font-weight:600;font-style:normal;text-decoration:none}" +
+    ".quran-widget__error{margin:0;color:var(--qw-muted)}";
+
+  function injectStyle() {
+    if (document.getElementById("quran-widget-style")) {
+      return;
+    }
+    var style = document.createElement("style");
+    style.id = "quran-widget-style";
+    style.textContent = css;
+    document.head.appendChild(style);
+  }
+
+  function el(tag, className, text) {
+    var node = document.createElement(tag);
+    node.className = className;
+    if (text !== undefined) {
+      node.textContent = text;
+    }
+    return node;
+  }
+
+  // Arabic-Indic digits mark the end of each ayah, as in the mushaf.
+  function ayahMark(n) {
+    return " ۝" + String(n).replace(/\d/g, function (d) {
+      return String.fromCharCode(0x0660 + Number(d));
+    });
+  }
+
+  function renderResult(container, result, langs) {
+    var surah = result.surah;
+    result.ayahs.forEach(function (ayah) {
+      var block = el("div", "quran-widget__ayah");
+      var arabic = el("p", "quran-widget__arabic", ayah.text_uthmani);
+      arabic.lang = "ar";
+      arabic.dir = "rtl";
+      arabic.appendChild(el("span", "quran-widget__number", ayahMark(ayah.number_in_surah)));
+      block.appendChild(arabic);
+
+      langs.forEach(function (lang) {
+        var text = ayah.translations ? ayah.translations[lang] : ayah.translation;
+        if (!text) {
+          return;
+        }
+        var p = el("p", "quran-widget__translation", text);
+        p.lang = lang;
+        block.appendChild(p);
+      });
+      container.appendChild(block);
+    });
+
+    var span = result.reference.split(":")[1];
+    var cite = el("a", "quran-widget__cite", "QS. " + surah.name_latin + " [" + surah.id + "]: " + span);
+    var first = result.ayahs[0];
+    cite.href = defaults.api + "/s/" + surah.id + "/" + first.number_in_surah + "?lang=" + langs[0];
+    cite.target = "_blank";
+    cite.rel = "noopener";
+    container.appendChild(cite);
+  }
+
+  function render(node) {
+    var ref = node.getAttribute("data-quran-ref");
+    if (!ref || node.getAttribute("data-quran-rendered")) {
+      return;
+    }
+    node.setAttribute("data-quran-rendered", "true");
+
+    var theme = node.getAttribute("data-quran-theme") || defaults.theme;
+    if (themes.indexOf(theme) < 0) {
+      theme = "light";
+    }
+    var lang = node.getAttribute("data-quran-lang") || defaults.lang;
+    node.classList.add("quran-widget", "quran-widget--" + theme);
+    node.setAttribute("aria-busy", "true");
+
+    var url = defaults.api + "/v1/ref?q=" + encodeURIComponent(ref) + "&lang=" + encodeURIComponent(lang);
+    fetch(url, { headers: { Accept: "application/json" } })
+      .then(function (res) {
+        return res.json().then(function (body) {
+          if (!res.ok) {
+            throw new Error(body.error || res.statusText);
+          }
+          return body.data;
+        });
+      })
+      .then(function (data) {
+        node.textContent = "";
+        var langs = lang === "all" ? ["id", "en"] : lang.split(",");
+        data.results.forEach(function (result) {
+          renderResult(node, result, langs);
+        });
+      })
+      .catch(function (err) {
+        node.textContent = "";
+        node.appendChild(el("p", "quran-widget__error", ref));
+        if (window.console) {
+          console.warn("quran-widget: could not load " + ref + " (" + err.message + "). " +
+            "If this is a CORS error, add this site's origin to the API's ALLOWED_ORIGINS.");
+        }
+      })
+      .then(function () {
+        node.removeAttribute("aria-busy");
+      });
+  }
+
+  function renderAll(root) {
+    injectStyle();
+    root = root || document;
+    if (root.matches && root.matches("[data-quran-ref]")) {
+      render(root);
+    }
+    Array.prototype.forEach.call(root.querySelectorAll("[data-quran-ref]"), render);
+  }
+
+  window.QuranWidget = { render: renderAll };
+
+  if (document.readyState === "loading") {
+    document.addEventListener("DOMContentLoaded", function () {
+      renderAll();
+    });
+  } else {
+    renderAll();
+  }
+})();
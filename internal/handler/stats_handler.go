@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/stats"
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/pkg/response"
+)
+
+type StatsHandler struct {
+	service      stats.StatsService
+	surahService surah.SurahService
+}
+
+func NewStatsHandler(service stats.StatsService, surahService surah.SurahService) *StatsHandler {
+	return &StatsHandler{service: service, surahService: surahService}
+}
+
+// Overview godoc
+// @Summary     Mushaf statistics
+// @Description Words, letters (without diacritics), characters and estimated recitation time of the whole mushaf, with every surah and juz. percent is a part's share of the mushaf's letters and progress the percentage read once it is finished.
+// @Tags        Stats
+// @Produce     json
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. number,percent"
+// @Success     200  {object} response.SuccessResponse{data=stats.Overview}
+// @Failure     500  {object} response.ErrorResponse
+// @Failure     503  {object} response.ErrorResponse
+// @Router      /stats [get]
+func (h *StatsHandler) Overview(c *gin.Context) {
+	overview, err := h.service.GetOverview(c.Request.Context())
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	respond(c, overview, h.surahService)
+}
+
+// Surah godoc
+// @Summary     Surah statistics
+// @Description Text statistics of a surah and of each of its ayahs, with letter-weighted progress through the mushaf
+// @Tags        Stats
+// @Produce     json
+// @Param       id       path     string  true   "Surah ID (1-114) or name, e.g. 36 or yasin"
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,progress"
+// @Param       include  query    string  false  "Comma-separated expansions: surah"
+// @Success     200  {object} response.SuccessResponse{data=stats.Detail}
+// @Failure     400  {object} response.ErrorResponse
+// @Failure     404  {object} response.ErrorResponse
+// @Failure     500  {object} response.ErrorResponse
+// @Failure     503  {object} response.ErrorResponse
+// @Router      /surah/{id}/stats [get]
+func (h *StatsHandler) Surah(c *gin.Context) {
+	var p surahPath
+	if !bind(c, &p) {
+		return
+	}
+	detail, err := h.service.GetSurah(c.Request.Context(), int(p.ID))
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	if detail == nil {
+		response.NotFound(c, domain.CodeSurahNotFound, "surah not found")
+		return
+	}
+	respond(c, detail, h.surahService)
+}
+
+// Juz godoc
+// @Summary     Juz statistics
+// @Description Text statistics of a juz and of each of its ayahs, with letter-weighted progress through the mushaf
+// @Tags        Stats
+// @Produce     json
+// @Param       number   path     int     true   "Juz number (1-30)"  minimum(1)  maximum(30)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,progress"
+// @Param       include  query    string  false  "Comma-separated expansions: surah"
+// @Success     200  {object} response.SuccessResponse{data=stats.Detail}
+// @Failure     400  {object} response.ErrorResponse
+// @Failure     404  {object} response.ErrorResponse
+// @Failure     500  {object} response.ErrorResponse
+// @Failure     503  {object} response.ErrorResponse
+// @Router      /juz/{number}/stats [get]
+func (h *StatsHandler) Juz(c *gin.Context) {
+	var p juzPath
+	if !bind(c, &p) {
+		return
+	}
+	detail, err := h.service.GetJuz(c.Request.Context(), p.Number)
+	if err != nil {
+		h.fail(c, err)
+		return
+	}
+	if detail == nil {
+		response.NotFound(c, domain.CodeJuzNotFound, "juz not found")
+		return
+	}
+	respond(c, detail, h.surahService)
+}
+
+func (h *StatsHandler) fail(c *gin.Context, err error) {
+	if errors.Is(err, domain.ErrDatasetIncomplete) {
+		response.ServiceUnavailable(c, domain.CodeDatasetIncomplete, "the dataset has no text statistics")
+		return
+	}
+	response.InternalError(c)
+}
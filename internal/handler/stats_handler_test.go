@@ -0,0 +1,140 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/stats"
+	"quran-api-go/internal/handler"
+)
+
+type MockStatsService struct {
+	GetOverviewFunc func(ctx context.Context) (*stats.Overview, error)
+	GetSurahFunc    func(ctx context.Context, id int) (*stats.Detail, error)
+	GetJuzFunc      func(ctx context.Context, number int) (*stats.Detail, error)
+}
+
+func (m *MockStatsService) GetOverview(ctx context.Context) (*stats.Overview, error) {
+	if m.GetOverviewFunc != nil {
+		return m.GetOverviewFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockStatsService) GetSurah(ctx context.Context, id int) (*stats.Detail, error) {
+	if m.GetSurahFunc != nil {
+		return m.GetSurahFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockStatsService) GetJuz(ctx context.Context, number int) (*stats.Detail, error) {
+	if m.GetJuzFunc != nil {
+		return m.GetJuzFunc(ctx, number)
+	}
+	return nil, nil
+}
+
+func setupStatsRouter(svc stats.StatsService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	h := handler.NewStatsHandler(svc, &MockSurahService{})
+	r := gin.New()
+	r.GET("/stats", h.Overview)
+	r.GET("/surah/:id/stats", h.Surah)
+	r.GET("/juz/:number/stats", h.Juz)
+	return r
+}
+
+func TestStatsHandler_Overview(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		r := setupStatsRouter(&MockStatsService{
+			GetOverviewFunc: func(ctx context.Context) (*stats.Overview, error) {
+				return &stats.Overview{
+					Counts: stats.Counts{Ayahs: 6236, Words: 77430, Letters: 325000},
+					Surahs: []stats.Part{{Number: 1, Counts: stats.Counts{Ayahs: 7}, Percent: 0.044, Progress: 0.044}},
+				}, nil
+			},
+		})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/stats", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		data := decodeData(t, w.Body.Bytes())
+		if data["ayahs"] != float64(6236) || data["words"] != float64(77430) {
+			t.Errorf("unexpected totals: %v", data)
+		}
+		surahs := data["surahs"].([]any)
+		if len(surahs) != 1 || surahs[0].(map[string]any)["progress"] != 0.044 {
+			t.Errorf("unexpected surahs: %v", surahs)
+		}
+	})
+
+	t.Run("DatasetIncomplete", func(t *testing.T) {
+		r := setupStatsRouter(&MockStatsService{
+			GetOverviewFunc: func(ctx context.Context) (*stats.Overview, error) {
+				return nil, domain.ErrDatasetIncomplete
+			},
+		})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/stats", nil))
+		if w.Code != http.StatusServiceUnavailable {
+			t.Fatalf("expected status 503, got %d", w.Code)
+		}
+		if body := decodeBody(t, w.Body.Bytes()); body["code"] != string(domain.CodeDatasetIncomplete) {
+			t.Errorf("unexpected body: %v", body)
+		}
+	})
+}
+
+func TestStatsHandler_Surah(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		r := setupStatsRouter(&MockStatsService{
+			GetSurahFunc: func(ctx context.Context, id int) (*stats.Detail, error) {
+				return &stats.Detail{
+					Part:        stats.Part{Number: id, Counts: stats.Counts{Ayahs: 7}},
+					FirstAyahID: 1,
+					LastAyahID:  7,
+				}, nil
+			},
+		})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/1/stats", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		if data := decodeData(t, w.Body.Bytes()); data["number"] != float64(1) || data["last_ayah_id"] != float64(7) {
+			t.Errorf("unexpected data: %v", data)
+		}
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		r := setupStatsRouter(&MockStatsService{})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/115/stats", nil))
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+	})
+}
+
+func TestStatsHandler_Juz(t *testing.T) {
+	t.Run("NotFound", func(t *testing.T) {
+		r := setupStatsRouter(&MockStatsService{})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/juz/30/stats", nil))
+		if w.Code != http.StatusNotFound {
+			t.Fatalf("expected status 404, got %d", w.Code)
+		}
+	})
+}
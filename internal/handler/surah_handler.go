@@ -23,25 +23,29 @@ func NewSurahHandler(service surah.SurahService) *SurahHandler {
 // @Summary     List all surahs
 // @Description Get a list of all 114 surahs, optionally filtered by revelation type
 // @Tags        Surah
-// @Produce     json
+// @Produce     json,text/csv,application/x-ndjson,text/markdown,text/plain
 // @Param       type  query    string  false  "Filter by revelation type"  Enums(meccan, medinan)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words"
+// @Param       format   query    string  false  "Output format (overrides Accept)"  Enums(json, csv, ndjson, markdown, text)
 // @Success     200   {object} response.SuccessResponse{data=[]surah.Surah}
 // @Failure     400   {object} response.ErrorResponse
 // @Failure     500   {object} response.ErrorResponse
 // @Router      /surah [get]
 func (h *SurahHandler) List(c *gin.Context) {
-	revelationType := c.Query("type")
-	if revelationType != "" {
-		if revelationType != "meccan" && revelationType != "medinan" {
-			response.BadRequest(c, "type must be 'meccan' or 'medinan'")
-			return
-		}
-		surahs, err := h.service.GetByRevelationType(c.Request.Context(), revelationType)
+	var q struct {
+		Type string `query:"type" validate:"oneof=meccan medinan" code:"INVALID_REVELATION_TYPE"`
+	}
+	if !bind(c, &q) {
+		return
+	}
+	if q.Type != "" {
+		surahs, err := h.service.GetByRevelationType(c.Request.Context(), q.Type)
 		if err != nil {
 			response.InternalError(c)
 			return
 		}
-		response.Success(c, surahs)
+		respondList(c, surahs, func() response.Table { return newSurahTable(surahs) }, nil, h.service)
 		return
 	}
 
@@ -51,36 +55,87 @@ func (h *SurahHandler) List(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, surahs)
+	respondList(c, surahs, func() response.Table { return newSurahTable(surahs) }, nil, h.service)
 }
 
 // Detail godoc
-// @Summary     Get surah by ID
-// @Description Get detailed information about a specific surah
+// @Summary     Get surah by ID or name
+// @Description Get detailed information about a specific surah, by number or by name (a slug such as al-baqarah or any spelling /surah/search matches exactly)
 // @Tags        Surah
 // @Produce     json
-// @Param       id   path     int  true  "Surah ID (1-114)"  minimum(1)  maximum(114)
+// @Param       id   path     string  true  "Surah ID (1-114) or name, e.g. 36 or yasin"
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. id,text_uthmani"
+// @Param       include  query    string  false  "Comma-separated expansions: surah, words"
 // @Success     200  {object} response.SuccessResponse{data=surah.Surah}
 // @Failure     400  {object} response.ErrorResponse
 // @Failure     404  {object} response.ErrorResponse
 // @Failure     500  {object} response.ErrorResponse
 // @Router      /surah/{id} [get]
 func (h *SurahHandler) Detail(c *gin.Context) {
-	id, err := strconv.Atoi(c.Param("id"))
-	if err != nil {
-		response.BadRequest(c, "invalid surah id")
+	var p surahPath
+	if !bind(c, &p) {
 		return
 	}
 
-	s, err := h.service.GetByID(c.Request.Context(), id)
+	s, err := h.service.GetByID(c.Request.Context(), int(p.ID))
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
-			response.NotFound(c, "surah not found")
+			response.NotFound(c, domain.CodeSurahNotFound, "surah not found")
 			return
 		}
 		response.InternalError(c)
 		return
 	}
 
-	response.Success(c, s)
+	respond(c, s, h.service)
+}
+
+// Search godoc
+// @Summary     Find surahs by name
+// @Description Fuzzy search over surah names, best match first. Accepts Indonesian and international transliterations with their spelling variants (Yasin, Yaasiin, Ya Sin), slugs, Arabic names, English meanings (The Cow) and partial names (baq). score is 1 for an exact match and lower for looser ones; matched is the spelling that matched.
+// @Tags        Surah
+// @Produce     json
+// @Param       q        query    string  true   "Surah name in any spelling"
+// @Param       limit    query    int     false  "Maximum results (1-114)"  default(10)
+// @Param       fields   query    string  false  "Comma-separated fields to keep, e.g. number,slug,score"
+// @Success     200  {object} response.SuccessResponse{data=[]surah.Match}
+// @Failure     400  {object} response.ErrorResponse
+// @Failure     500  {object} response.ErrorResponse
+// @Router      /surah/search [get]
+func (h *SurahHandler) Search(c *gin.Context) {
+	var q struct {
+		Query string `query:"q" validate:"required" code:"INVALID_SEARCH_QUERY"`
+		Limit int    `query:"limit" default:"10" validate:"min=1,max=114" code:"INVALID_PAGINATION"`
+	}
+	if !bind(c, &q) {
+		return
+	}
+
+	matches, err := h.service.Search(c.Request.Context(), q.Query, q.Limit)
+	if err != nil {
+		response.InternalError(c)
+		return
+	}
+	respond(c, matches, h.service)
+}
+
+func newSurahTable(surahs []surah.Surah) response.Table {
+	table := response.Table{Columns: []string{
+		"id", "number", "name_arabic", "name_latin", "name_transliteration", "number_of_ayahs", "revelation_type",
+	}}
+	for _, s := range surahs {
+		table.Rows = append(table.Rows, response.TableRow{
+			Values: []string{
+				strconv.Itoa(s.ID),
+				strconv.Itoa(s.Number),
+				s.NameArabic,
+				s.NameLatin,
+				s.NameTransliteration,
+				strconv.Itoa(s.NumberOfAyahs),
+				s.RevelationType,
+			},
+			Item: s,
+		})
+	}
+	return table
 }
@@ -19,6 +19,7 @@ import (
 type mockSurahService struct {
 	getAllFn  func(ctx context.Context) ([]surah.Surah, error)
 	getByIDFn func(ctx context.Context, id int) (*surah.Surah, error)
+	searchFn  func(ctx context.Context, q string, limit int) ([]surah.Match, error)
 }
 
 func (m *mockSurahService) GetAll(ctx context.Context) ([]surah.Surah, error) {
@@ -29,10 +30,19 @@ func (m *mockSurahService) GetByID(ctx context.Context, id int) (*surah.Surah, e
 	return m.getByIDFn(ctx, id)
 }
 
+func (m *mockSurahService) GetByRevelationType(ctx context.Context, revelationType string) ([]surah.Surah, error) {
+	return nil, nil
+}
+
+func (m *mockSurahService) Search(ctx context.Context, q string, limit int) ([]surah.Match, error) {
+	return m.searchFn(ctx, q, limit)
+}
+
 func newTestRouter(h *handler.SurahHandler) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
 	r.GET("/surah", h.List)
+	r.GET("/surah/search", h.Search)
 	r.GET("/surah/:id", h.Detail)
 	return r
 }
@@ -95,6 +105,31 @@ func TestSurahHandler_Detail_OK(t *testing.T) {
 	}
 }
 
+func TestSurahHandler_Detail_BySlug(t *testing.T) {
+	for _, name := range []string{"yasin", "Yaasiin", "ya-sin"} {
+		t.Run(name, func(t *testing.T) {
+			var gotID int
+			svc := &mockSurahService{
+				getByIDFn: func(_ context.Context, id int) (*surah.Surah, error) {
+					gotID = id
+					return &surah.Surah{ID: id, Number: id, Slug: "yasin"}, nil
+				},
+			}
+			r := newTestRouter(handler.NewSurahHandler(svc))
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/"+name, nil))
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected 200, got %d", w.Code)
+			}
+			if gotID != 36 {
+				t.Errorf("expected surah 36, got %d", gotID)
+			}
+		})
+	}
+}
+
 func TestSurahHandler_Detail_NotFound(t *testing.T) {
 	svc := &mockSurahService{
 		getByIDFn: func(_ context.Context, _ int) (*surah.Surah, error) {
@@ -104,11 +139,17 @@ func TestSurahHandler_Detail_NotFound(t *testing.T) {
 	r := newTestRouter(handler.NewSurahHandler(svc))
 
 	w := httptest.NewRecorder()
-	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/999", nil))
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/114", nil))
 
 	if w.Code != http.StatusNotFound {
 		t.Fatalf("expected 404, got %d", w.Code)
 	}
+	var body struct {
+		Code string `json:"code"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil || body.Code != "SURAH_NOT_FOUND" {
+		t.Errorf("expected code SURAH_NOT_FOUND, got %s", w.Body)
+	}
 }
 
 func TestSurahHandler_Detail_InvalidID(t *testing.T) {
@@ -116,11 +157,42 @@ func TestSurahHandler_Detail_InvalidID(t *testing.T) {
 	r := newTestRouter(handler.NewSurahHandler(svc))
 
 	w := httptest.NewRecorder()
-	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/abc", nil))
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/115", nil))
 
 	if w.Code != http.StatusBadRequest {
 		t.Fatalf("expected 400, got %d", w.Code)
 	}
+	var body struct {
+		Code    string         `json:"code"`
+		Details map[string]any `json:"details"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if body.Code != "INVALID_SURAH_ID" || body.Details["param"] != "id" || body.Details["max"] != float64(114) {
+		t.Errorf("unexpected error body %s", w.Body)
+	}
+}
+
+func TestSurahHandler_Detail_UnknownName(t *testing.T) {
+	svc := &mockSurahService{}
+	r := newTestRouter(handler.NewSurahHandler(svc))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/foo", nil))
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", w.Code)
+	}
+	var body struct {
+		Code string `json:"code"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if body.Code != "SURAH_NOT_FOUND" {
+		t.Errorf("expected SURAH_NOT_FOUND, got %s", body.Code)
+	}
 }
 
 func TestSurahHandler_Detail_InternalError(t *testing.T) {
@@ -138,3 +210,47 @@ func TestSurahHandler_Detail_InternalError(t *testing.T) {
 		t.Fatalf("expected 500, got %d", w.Code)
 	}
 }
+
+func TestSurahHandler_Search(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		var gotQ string
+		var gotLimit int
+		svc := &mockSurahService{
+			searchFn: func(_ context.Context, q string, limit int) ([]surah.Match, error) {
+				gotQ, gotLimit = q, limit
+				return []surah.Match{{Surah: surah.Surah{ID: 36, Number: 36, Slug: "yasin"}, Matched: "Yasin", Score: 1}}, nil
+			},
+		}
+		r := newTestRouter(handler.NewSurahHandler(svc))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/search?q=Ya+Sin", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d: %s", w.Code, w.Body)
+		}
+		if gotQ != "Ya Sin" || gotLimit != 10 {
+			t.Errorf("service called with %q, %d", gotQ, gotLimit)
+		}
+		var body struct {
+			Data []map[string]any `json:"data"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if len(body.Data) != 1 || body.Data[0]["slug"] != "yasin" || body.Data[0]["matched"] != "Yasin" || body.Data[0]["score"] != float64(1) {
+			t.Errorf("unexpected data %v", body.Data)
+		}
+	})
+
+	t.Run("MissingQuery", func(t *testing.T) {
+		r := newTestRouter(handler.NewSurahHandler(&mockSurahService{}))
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah/search", nil))
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", w.Code)
+		}
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"quran-api-go/internal/domain/juz"
 	"quran-api-go/internal/domain/search"
 	"quran-api-go/internal/domain/surah"
+	"quran-api-go/pkg/reference"
 )
 
 type server struct {
@@ -63,6 +64,11 @@ func New(
 		Description: "Get a single ayah using surah number and position within that surah. Example: surah_id=2, number=255 returns Ayat al-Kursi.",
 	}, s.getAyahByRef)
 
+	mcp.AddTool(srv, &mcp.Tool{
+		Name:        "get_ayahs_by_reference",
+		Description: "Get ayahs from a human-written verse reference such as '2:255', 'QS 2:1-5', 'Al-Baqarah 255', 'yasin 1-12', '2:255, 257' or the cross-surah range '2:286-3:5'. Surah names may use Indonesian or English spelling. A reference may cover at most 300 ayahs.",
+	}, s.getAyahsByReference)
+
 	mcp.AddTool(srv, &mcp.Tool{
 		Name:        "random_ayah",
 		Description: "Get a random ayah from the Quran. Set surah_id to 0 for any surah, or a specific number (1-114) to restrict the pick.",
@@ -114,6 +120,10 @@ type getAyahByRefInput struct {
 	Number  int `json:"number"   jsonschema:"Ayah position within the surah (starts at 1)"`
 }
 
+type getAyahsByReferenceInput struct {
+	Reference string `json:"reference" jsonschema:"Verse reference, e.g. '2:255', 'QS 2:1-5', 'Al-Baqarah 255', 'yasin 1-12' or '2:286-3:5'"`
+}
+
 type randomAyahInput struct {
 	SurahID int `json:"surah_id" jsonschema:"Restrict random pick to this surah number; 0 means any surah"`
 }
@@ -155,6 +165,18 @@ type ayahOutput struct {
 	ayah.Ayah
 }
 
+type referenceOutput struct {
+	Results []referenceSpanOutput `json:"results"`
+}
+
+type referenceSpanOutput struct {
+	Reference string      `json:"reference"`
+	SurahID   int         `json:"surah_id"`
+	From      int         `json:"from"`
+	To        int         `json:"to"`
+	Ayahs     []ayah.Ayah `json:"ayahs"`
+}
+
 type listJuzOutput struct {
 	Juz []juz.Juz `json:"juz"`
 }
@@ -245,6 +267,31 @@ func (s *server) getAyahByRef(ctx context.Context, _ *mcp.CallToolRequest, in ge
 	return nil, ayahOutput{*result}, nil
 }
 
+func (s *server) getAyahsByReference(ctx context.Context, _ *mcp.CallToolRequest, in getAyahsByReferenceInput) (*mcp.CallToolResult, referenceOutput, error) {
+	spans, err := reference.Parse(in.Reference)
+	if err != nil {
+		return nil, referenceOutput{}, err
+	}
+	if total := reference.Len(spans); total > reference.MaxAyahs {
+		return nil, referenceOutput{}, fmt.Errorf("reference covers %d ayahs, maximum is %d", total, reference.MaxAyahs)
+	}
+	results := make([]referenceSpanOutput, 0, len(spans))
+	for _, span := range spans {
+		ayahs, err := s.ayahSvc.GetBySurah(ctx, span.SurahID, span.From, span.To)
+		if err != nil {
+			return nil, referenceOutput{}, err
+		}
+		results = append(results, referenceSpanOutput{
+			Reference: span.String(),
+			SurahID:   span.SurahID,
+			From:      span.From,
+			To:        span.To,
+			Ayahs:     ayahs,
+		})
+	}
+	return nil, referenceOutput{Results: results}, nil
+}
+
 func (s *server) randomAyah(ctx context.Context, _ *mcp.CallToolRequest, in randomAyahInput) (*mcp.CallToolResult, ayahOutput, error) {
 	result, err := s.ayahSvc.GetRandom(ctx, in.SurahID)
 	if err != nil {
@@ -0,0 +1,48 @@
+package mcpserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"quran-api-go/internal/domain/ayah"
+)
+
+// countingAyahs records GetBySurah calls; other methods are not used.
+type countingAyahs struct {
+	ayah.AyahService
+	calls int
+}
+
+func (a *countingAyahs) GetBySurah(ctx context.Context, surahID, from, to int) ([]ayah.Ayah, error) {
+	a.calls++
+	return []ayah.Ayah{{SurahID: surahID, NumberInSurah: from}}, nil
+}
+
+func TestGetAyahsByReference(t *testing.T) {
+	t.Run("within limit", func(t *testing.T) {
+		ayahs := &countingAyahs{}
+		s := &server{ayahSvc: ayahs}
+
+		_, out, err := s.getAyahsByReference(context.Background(), nil, getAyahsByReferenceInput{Reference: "2:255; 112"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(out.Results) != 2 || ayahs.calls != 2 {
+			t.Errorf("got %d results from %d queries", len(out.Results), ayahs.calls)
+		}
+	})
+
+	t.Run("over limit is refused before querying", func(t *testing.T) {
+		ayahs := &countingAyahs{}
+		s := &server{ayahSvc: ayahs}
+
+		_, _, err := s.getAyahsByReference(context.Background(), nil, getAyahsByReferenceInput{Reference: "2:1-3:200"})
+		if err == nil || !strings.Contains(err.Error(), "maximum is 300") {
+			t.Fatalf("expected limit error, got %v", err)
+		}
+		if ayahs.calls != 0 {
+			t.Errorf("expected no queries, got %d", ayahs.calls)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CacheOptions configures Cache for one route group.
+type CacheOptions struct {
+	// Version identifies the dataset; it is mixed into every ETag so a
+	// re-seeded database invalidates all cached responses.
+	Version string
+	// LastModified is when the dataset last changed. Zero disables
+	// Last-Modified and If-Modified-Since handling.
+	LastModified time.Time
+	// CacheControl is sent verbatim, e.g. "public, max-age=86400".
+	CacheControl string
+}
+
+// volatileTimestamp matches the response envelope's generation time, which
+// changes on every request and is left out of the ETag.
+var volatileTimestamp = regexp.MustCompile(`"timestamp":"[^"]*"`)
+
+// Cache adds strong ETags, Last-Modified and Cache-Control to successful GET
+// and HEAD responses, and answers If-None-Match / If-Modified-Since with
+// 304 Not Modified. The ETag is a hash of the dataset version and the
+// response body without its timestamp.
+func Cache(opts CacheOptions) gin.HandlerFunc {
+	lastModified := ""
+	if !opts.LastModified.IsZero() {
+		lastModified = opts.LastModified.UTC().Format(http.TimeFormat)
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		buf := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = buf
+		c.Next()
+		c.Writer = original
+
+		if buf.status != http.StatusOK {
+			original.WriteHeader(buf.status)
+			_, _ = original.Write(buf.body.Bytes())
+			return
+		}
+
+		sum := sha256.New()
+		sum.Write([]byte(opts.Version))
+		sum.Write([]byte{0})
+		sum.Write(volatileTimestamp.ReplaceAll(buf.body.Bytes(), nil))
+		etag := `"` + hex.EncodeToString(sum.Sum(nil)[:16]) + `"`
+
+		header := original.Header()
+		header.Set("ETag", etag)
+		if opts.CacheControl != "" {
+			header.Set("Cache-Control", opts.CacheControl)
+		}
+		if lastModified != "" {
+			header.Set("Last-Modified", lastModified)
+		}
+
+		if notModified(c.Request, etag, opts.LastModified) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			original.WriteHeader(http.StatusNotModified)
+			original.WriteHeaderNow()
+			return
+		}
+
+		original.WriteHeader(http.StatusOK)
+		_, _ = original.Write(buf.body.Bytes())
+	}
+}
+
+// notModified evaluates the request's conditional headers (RFC 9110 §13.2.2):
+// If-None-Match takes precedence and If-Modified-Since is only consulted
+// when it is absent.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		return !lastModified.Truncate(time.Second).After(since)
+	}
+
+	return false
+}
+
+// bufferedWriter holds the handler's response so the ETag can be computed
+// before anything reaches the client.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *bufferedWriter) WriteHeader(code int) { w.status = code }
+
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Flush() {}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+
+func (w *bufferedWriter) WriteString(s string) (int, error) { return w.body.WriteString(s) }
+
+func (w *bufferedWriter) Status() int { return w.status }
+
+func (w *bufferedWriter) Size() int { return w.body.Len() }
+
+func (w *bufferedWriter) Written() bool { return w.body.Len() > 0 }
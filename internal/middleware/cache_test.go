@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newCacheTestRouter(opts CacheOptions, stamp *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(Cache(opts))
+	r.GET("/surah", func(c *gin.Context) {
+		*stamp++
+		c.JSON(http.StatusOK, gin.H{"data": "al-fatihah", "timestamp": time.Unix(int64(*stamp), 0).UTC().Format(time.RFC3339)})
+	})
+	r.GET("/missing", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+	})
+	return r
+}
+
+func TestCache(t *testing.T) {
+	modTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	stamp := 0
+	r := newCacheTestRouter(CacheOptions{Version: "v1", LastModified: modTime, CacheControl: "public, max-age=60"}, &stamp)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah", nil))
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" || w.Body.Len() == 0 {
+		t.Fatalf("expected 200 with ETag and body, got %d %q", w.Code, etag)
+	}
+	if got := w.Header().Get("Cache-Control"); got != "public, max-age=60" {
+		t.Fatalf("unexpected Cache-Control %q", got)
+	}
+	if got := w.Header().Get("Last-Modified"); got != modTime.Format(http.TimeFormat) {
+		t.Fatalf("unexpected Last-Modified %q", got)
+	}
+
+	t.Run("If-None-Match ignores envelope timestamp", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/surah", nil)
+		req.Header.Set("If-None-Match", `"other", `+etag)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+			t.Fatalf("expected empty 304, got %d %q", w.Code, w.Body.String())
+		}
+		if w.Header().Get("ETag") != etag {
+			t.Fatalf("expected ETag on 304")
+		}
+	})
+
+	t.Run("Stale If-None-Match wins over If-Modified-Since", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/surah", nil)
+		req.Header.Set("If-None-Match", `"stale"`)
+		req.Header.Set("If-Modified-Since", modTime.Format(http.TimeFormat))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d", w.Code)
+		}
+	})
+
+	t.Run("If-Modified-Since", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/surah", nil)
+		req.Header.Set("If-Modified-Since", modTime.Add(time.Hour).Format(http.TimeFormat))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusNotModified {
+			t.Fatalf("expected 304, got %d", w.Code)
+		}
+	})
+
+	t.Run("Dataset version changes the ETag", func(t *testing.T) {
+		other := newCacheTestRouter(CacheOptions{Version: "v2"}, &stamp)
+		w := httptest.NewRecorder()
+		other.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/surah", nil))
+
+		if got := w.Header().Get("ETag"); got == "" || got == etag {
+			t.Fatalf("expected a different ETag, got %q", got)
+		}
+	})
+
+	t.Run("Errors are not cached", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/missing", nil))
+
+		if w.Code != http.StatusNotFound || w.Header().Get("ETag") != "" || w.Body.Len() == 0 {
+			t.Fatalf("expected uncached 404 with body, got %d %q", w.Code, w.Header().Get("ETag"))
+		}
+	})
+}
@@ -0,0 +1,269 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"container/list"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Supported content codings, in server preference order for equal q-values.
+const (
+	encodingBrotli = "br"
+	encodingZstd   = "zstd"
+	encodingGzip   = "gzip"
+)
+
+var encodingPreference = []string{encodingBrotli, encodingZstd, encodingGzip}
+
+// CompressOptions configures Compress.
+type CompressOptions struct {
+	// MinSize is the smallest body worth compressing; smaller bodies go out
+	// as-is. Defaults to 1024 bytes.
+	MinSize int
+	// CacheEntries bounds the cache of compressed bodies, keyed by encoding
+	// and ETag, so responses from Cache-enabled dataset routes are compressed
+	// once per dataset version. A cached body keeps the envelope timestamp of
+	// the response it was built from. Zero disables the cache.
+	CacheEntries int
+	// ExcludePaths are never buffered or compressed, e.g. streaming endpoints.
+	ExcludePaths []string
+}
+
+// Compress compresses responses with brotli, zstd or gzip according to the
+// request's Accept-Encoding. Small bodies, already-encoded bodies, byte-range
+// responses and audio, video and image content are sent unchanged.
+//
+// Compressed representations get their own strong ETag ("<etag>-br"), and
+// such suffixes are stripped from If-None-Match before inner handlers see
+// it, so Cache keeps matching.
+func Compress(opts CompressOptions) gin.HandlerFunc {
+	if opts.MinSize <= 0 {
+		opts.MinSize = 1024
+	}
+	excluded := make(map[string]struct{}, len(opts.ExcludePaths))
+	for _, path := range opts.ExcludePaths {
+		excluded[path] = struct{}{}
+	}
+	var cache *compressedCache
+	if opts.CacheEntries > 0 {
+		cache = newCompressedCache(opts.CacheEntries)
+	}
+
+	return func(c *gin.Context) {
+		if _, ok := excluded[c.Request.URL.Path]; ok {
+			c.Next()
+			return
+		}
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" || c.GetHeader("Range") != "" {
+			c.Next()
+			return
+		}
+		matched := stripETagSuffix(c.Request, encoding)
+
+		original := c.Writer
+		buf := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = buf
+		c.Next()
+		c.Writer = original
+
+		header := original.Header()
+		body := buf.body.Bytes()
+
+		if buf.status == http.StatusNotModified {
+			if etag := header.Get("ETag"); etag != "" && matched {
+				header.Set("ETag", suffixETag(etag, encoding))
+			}
+			original.WriteHeader(buf.status)
+			original.WriteHeaderNow()
+			return
+		}
+
+		if !compressible(buf.status, header, len(body), opts.MinSize) {
+			original.WriteHeader(buf.status)
+			_, _ = original.Write(body)
+			return
+		}
+
+		etag := header.Get("ETag")
+		var compressed []byte
+		if cache != nil && etag != "" {
+			compressed, _ = cache.get(encoding + etag)
+		}
+		if compressed == nil {
+			var err error
+			compressed, err = compress(encoding, body)
+			if err != nil {
+				original.WriteHeader(buf.status)
+				_, _ = original.Write(body)
+				return
+			}
+			if cache != nil && etag != "" {
+				cache.add(encoding+etag, compressed)
+			}
+		}
+
+		header.Set("Content-Encoding", encoding)
+		header.Del("Content-Length")
+		if etag != "" {
+			header.Set("ETag", suffixETag(etag, encoding))
+		}
+		original.WriteHeader(buf.status)
+		_, _ = original.Write(compressed)
+	}
+}
+
+// negotiateEncoding picks the best supported coding for an Accept-Encoding
+// header (RFC 9110 §12.5.3), or "" for identity.
+func negotiateEncoding(header string) string {
+	if header == "" {
+		return ""
+	}
+
+	q := map[string]float64{}
+	wildcard := -1.0
+	for _, part := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		weight := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		if coding == "*" {
+			wildcard = weight
+		} else {
+			q[coding] = weight
+		}
+	}
+
+	best, bestQ := "", 0.0
+	for _, coding := range encodingPreference {
+		weight, ok := q[coding]
+		if !ok {
+			weight = wildcard
+		}
+		if weight > bestQ {
+			best, bestQ = coding, weight
+		}
+	}
+	return best
+}
+
+func compressible(status int, header http.Header, size, minSize int) bool {
+	if status < 200 || status == http.StatusNoContent || status == http.StatusPartialContent {
+		return false
+	}
+	if size < minSize || header.Get("Content-Encoding") != "" || header.Get("Content-Range") != "" {
+		return false
+	}
+	contentType := header.Get("Content-Type")
+	for _, prefix := range []string{"audio/", "video/", "image/"} {
+		if strings.HasPrefix(contentType, prefix) && !strings.HasPrefix(contentType, "image/svg") {
+			return false
+		}
+	}
+	return true
+}
+
+var zstdEncoder, _ = zstd.NewWriter(nil)
+
+func compress(encoding string, body []byte) ([]byte, error) {
+	if encoding == encodingZstd {
+		return zstdEncoder.EncodeAll(body, make([]byte, 0, len(body)/4)), nil
+	}
+
+	var out bytes.Buffer
+	var w interface {
+		Write([]byte) (int, error)
+		Close() error
+	}
+	switch encoding {
+	case encodingBrotli:
+		w = brotli.NewWriterLevel(&out, 5)
+	default:
+		w = gzip.NewWriter(&out)
+	}
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func suffixETag(etag, encoding string) string {
+	if !strings.HasSuffix(etag, `"`) {
+		return etag
+	}
+	return strings.TrimSuffix(etag, `"`) + "-" + encoding + `"`
+}
+
+// stripETagSuffix removes this middleware's encoding suffix from the
+// request's If-None-Match tags and reports whether any were found.
+func stripETagSuffix(r *http.Request, encoding string) bool {
+	inm := r.Header.Get("If-None-Match")
+	suffix := "-" + encoding + `"`
+	if !strings.Contains(inm, suffix) {
+		return false
+	}
+	r.Header.Set("If-None-Match", strings.ReplaceAll(inm, suffix, `"`))
+	return true
+}
+
+// compressedCache is a small LRU of compressed bodies.
+type compressedCache struct {
+	mu      sync.Mutex
+	max     int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type compressedEntry struct {
+	key  string
+	body []byte
+}
+
+func newCompressedCache(max int) *compressedCache {
+	return &compressedCache{max: max, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+func (c *compressedCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*compressedEntry).body, true
+}
+
+func (c *compressedCache) add(key string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&compressedEntry{key: key, body: body})
+	if c.order.Len() > c.max {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*compressedEntry).key)
+	}
+}
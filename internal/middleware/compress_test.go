@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/klauspost/compress/zstd"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := map[string]string{
+		"":                        "",
+		"identity":                "",
+		"gzip":                    "gzip",
+		"gzip, deflate, br, zstd": "br",
+		"gzip;q=1, br;q=0.5":      "gzip",
+		"zstd, gzip;q=0.8":        "zstd",
+		"*":                       "br",
+		"*, br;q=0":               "zstd",
+		"br;q=0, gzip;q=0":        "",
+	}
+	for header, want := range tests {
+		if got := negotiateEncoding(header); got != want {
+			t.Errorf("negotiateEncoding(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestCompress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	large := strings.Repeat("بِسْمِ ٱللَّهِ ٱلرَّحْمَٰنِ ٱلرَّحِيمِ ", 200)
+
+	r := gin.New()
+	r.Use(Compress(CompressOptions{MinSize: 1024, CacheEntries: 8}))
+	r.Use(Cache(CacheOptions{Version: "v1"}))
+	r.GET("/large", func(c *gin.Context) { c.String(http.StatusOK, large) })
+	r.GET("/small", func(c *gin.Context) { c.String(http.StatusOK, "ok") })
+	r.GET("/audio", func(c *gin.Context) { c.Data(http.StatusOK, "audio/mpeg", []byte(large)) })
+
+	decoders := map[string]func(io.Reader) (io.Reader, error){
+		"gzip": func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) },
+		"br":   func(r io.Reader) (io.Reader, error) { return brotli.NewReader(r), nil },
+		"zstd": func(r io.Reader) (io.Reader, error) { return zstd.NewReader(r) },
+	}
+	for encoding, decode := range decoders {
+		t.Run(encoding, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/large", nil)
+			req.Header.Set("Accept-Encoding", encoding)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if got := w.Header().Get("Content-Encoding"); got != encoding {
+				t.Fatalf("expected Content-Encoding %s, got %q", encoding, got)
+			}
+			if w.Body.Len() >= len(large) {
+				t.Fatalf("expected compressed body, got %d bytes", w.Body.Len())
+			}
+			dr, err := decode(bytes.NewReader(w.Body.Bytes()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			plain, err := io.ReadAll(dr)
+			if err != nil || string(plain) != large {
+				t.Fatalf("round trip failed: %v", err)
+			}
+			etag := w.Header().Get("ETag")
+			if !strings.HasSuffix(etag, "-"+encoding+`"`) {
+				t.Fatalf("expected encoding-specific ETag, got %q", etag)
+			}
+
+			req = httptest.NewRequest(http.MethodGet, "/large", nil)
+			req.Header.Set("Accept-Encoding", encoding)
+			req.Header.Set("If-None-Match", etag)
+			w = httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusNotModified || w.Header().Get("ETag") != etag {
+				t.Fatalf("expected 304 with %s, got %d %q", etag, w.Code, w.Header().Get("ETag"))
+			}
+		})
+	}
+
+	t.Run("Skips small bodies and audio", func(t *testing.T) {
+		for _, path := range []string{"/small", "/audio"} {
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req.Header.Set("Accept-Encoding", "gzip")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if got := w.Header().Get("Content-Encoding"); got != "" {
+				t.Errorf("%s: expected no Content-Encoding, got %q", path, got)
+			}
+		}
+	})
+
+	t.Run("Skips range requests", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/large", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		req.Header.Set("Range", "bytes=0-99")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if got := w.Header().Get("Content-Encoding"); got != "" {
+			t.Errorf("expected no Content-Encoding, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeprecationOptions describes how a deprecated route group is retired.
+type DeprecationOptions struct {
+	// Since is when the routes were deprecated, sent as the RFC 9745
+	// Deprecation header. It may be in the future to announce a deprecation.
+	Since time.Time
+	// Sunset is when the routes stop working, sent as the RFC 8594 Sunset
+	// header. Zero omits it.
+	Sunset time.Time
+	// Successor returns the replacement for the requested resource, linked
+	// with rel="successor-version". Nil or an empty result omits the link.
+	Successor func(c *gin.Context) string
+}
+
+// Deprecation marks every response of a route group as deprecated so
+// clients can find out, from any call, when to move and where to.
+func Deprecation(opts DeprecationOptions) gin.HandlerFunc {
+	since := "@" + strconv.FormatInt(opts.Since.Unix(), 10)
+	sunset := ""
+	if !opts.Sunset.IsZero() {
+		sunset = opts.Sunset.UTC().Format(http.TimeFormat)
+	}
+
+	return func(c *gin.Context) {
+		h := c.Writer.Header()
+		h.Set("Deprecation", since)
+		if sunset != "" {
+			h.Set("Sunset", sunset)
+		}
+		if opts.Successor != nil {
+			if successor := opts.Successor(c); successor != "" {
+				h.Add("Link", "<"+successor+`>; rel="successor-version"`)
+			}
+		}
+		c.Next()
+	}
+}
+
+// VersionSuccessor links an unversioned alias to the same resource under
+// prefix, keeping the query string: /surah/1?lang=en becomes
+// /v1/surah/1?lang=en.
+func VersionSuccessor(prefix string) func(c *gin.Context) string {
+	return func(c *gin.Context) string {
+		return prefix + c.Request.URL.RequestURI()
+	}
+}
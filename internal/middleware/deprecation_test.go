@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestDeprecation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	legacy := r.Group("", Deprecation(DeprecationOptions{
+		Since:     time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+		Sunset:    time.Date(2027, 6, 30, 0, 0, 0, 0, time.UTC),
+		Successor: VersionSuccessor("/v1"),
+	}))
+	legacy.GET("/juz/:number/ayah", func(c *gin.Context) {
+		c.Writer.Header().Add("Link", `</juz/30/ayah?page=2>; rel="next"`)
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	})
+	r.GET("/v1/juz/:number/ayah", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/juz/30/ayah?lang=en&page=1", nil))
+	if got := w.Header().Get("Deprecation"); got != "@1793491200" {
+		t.Errorf("Deprecation = %q", got)
+	}
+	if got := w.Header().Get("Sunset"); got != "Wed, 30 Jun 2027 00:00:00 GMT" {
+		t.Errorf("Sunset = %q", got)
+	}
+	links := w.Header().Values("Link")
+	want := []string{`</v1/juz/30/ayah?lang=en&page=1>; rel="successor-version"`, `</juz/30/ayah?page=2>; rel="next"`}
+	if len(links) != len(want) || links[0] != want[0] || links[1] != want[1] {
+		t.Errorf("Link = %q, want %q", links, want)
+	}
+
+	t.Run("successor route is not deprecated", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/juz/30/ayah", nil))
+		if w.Header().Get("Deprecation") != "" || w.Header().Get("Link") != "" {
+			t.Errorf("unexpected headers %v", w.Header())
+		}
+	})
+
+	t.Run("without sunset or successor", func(t *testing.T) {
+		r := gin.New()
+		r.GET("/old", Deprecation(DeprecationOptions{Since: time.Unix(0, 0)}), func(c *gin.Context) { c.Status(http.StatusNoContent) })
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/old", nil))
+		if w.Header().Get("Deprecation") != "@0" || w.Header().Get("Sunset") != "" || w.Header().Get("Link") != "" {
+			t.Errorf("unexpected headers %v", w.Header())
+		}
+	})
+}
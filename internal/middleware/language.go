@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/pkg/response"
+	"quran-api-go/pkg/validator"
+)
+
+// Language negotiates the client's preferred language from Accept-Language
+// and stores it under response.LangKey, where handlers pick it up when ?lang
+// is absent and pkg/response uses it to localise error messages.
+func Language() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", "Accept-Language")
+		if lang, ok := validator.NegotiateLang(c.GetHeader("Accept-Language")); ok {
+			c.Set(response.LangKey, lang)
+		}
+		c.Next()
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
+
+	"quran-api-go/pkg/response"
 )
 
 func Logging() gin.HandlerFunc {
@@ -19,6 +21,7 @@ func Logging() gin.HandlerFunc {
 			Int("status", c.Writer.Status()).
 			Str("ip", c.ClientIP()).
 			Dur("duration", duration).
+			Str("request_id", c.GetString(response.RequestIDKey)).
 			Msg("request completed")
 	}
 }
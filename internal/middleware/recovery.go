@@ -1,7 +1,16 @@
 package middleware
 
-import "github.com/gin-gonic/gin"
+import (
+	"github.com/gin-gonic/gin"
 
+	"quran-api-go/pkg/response"
+)
+
+// Recovery turns a panic into a regular INTERNAL_ERROR response, so even a
+// crash carries the request ID to quote in a bug report.
 func Recovery() gin.HandlerFunc {
-	return gin.Recovery()
+	return gin.CustomRecovery(func(c *gin.Context, _ any) {
+		response.InternalError(c)
+		c.Abort()
+	})
 }
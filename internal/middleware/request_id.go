@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/pkg/response"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// RequestID tags every request with an ID, echoed in the X-Request-ID
+// response header, in error bodies and in the access log. A well-formed
+// X-Request-ID from the client or a proxy is kept so one ID follows the
+// request end to end.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		c.Set(response.RequestIDKey, id)
+		c.Writer.Header().Set(requestIDHeader, id)
+		c.Next()
+	}
+}
+
+// validRequestID accepts up to 128 letters, digits, '-', '_', '.' and ':',
+// enough for UUIDs and common tracing IDs but nothing that could forge log
+// lines or headers.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(RequestID(), Recovery())
+	r.GET("/panic", func(c *gin.Context) { panic("boom") })
+
+	cases := []struct {
+		name, incoming string
+		keep           bool
+	}{
+		{"generated", "", false},
+		{"kept", "trace-01.abc:2", true},
+		{"replaced when malformed", "bad id\r\nX-Evil: 1", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/panic", nil)
+			if tc.incoming != "" {
+				req.Header.Set("X-Request-ID", tc.incoming)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			id := w.Header().Get("X-Request-ID")
+			if tc.keep && id != tc.incoming || !tc.keep && len(id) != 32 {
+				t.Fatalf("X-Request-ID = %q", id)
+			}
+			var body struct {
+				Code      string `json:"code"`
+				RequestID string `json:"request_id"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("invalid json %v", err)
+			}
+			if w.Code != http.StatusInternalServerError || body.Code != "INTERNAL_ERROR" || body.RequestID != id {
+				t.Errorf("status %d, body %s", w.Code, w.Body)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"quran-api-go/internal/domain/ayah"
+	"strings"
 )
 
 type AyahRepository struct {
@@ -54,6 +55,50 @@ func (a *AyahRepository) FindByID(ctx context.Context, id int) (*ayah.Ayah, erro
 	return &ayah, nil
 }
 
+func (a *AyahRepository) FindByIDs(ctx context.Context, ids []int) ([]ayah.Ayah, error) {
+	if len(ids) == 0 {
+		return []ayah.Ayah{}, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	query := `SELECT id, surah_id, number_in_surah, text_uthmani,
+		translation_indo, translation_en, juz_number, sajda_type, revelation_type
+		FROM ayahs WHERE id IN (` + placeholders + `)
+		ORDER BY id ASC`
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	rows, err := a.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ayahs []ayah.Ayah
+	for rows.Next() {
+		var ay ayah.Ayah
+		if err := rows.Scan(
+			&ay.ID,
+			&ay.SurahID,
+			&ay.NumberInSurah,
+			&ay.TextUthmani,
+			&ay.TranslationIdo,
+			&ay.TranslationEn,
+			&ay.JuzNumber,
+			&ay.SajdaType,
+			&ay.RevelationType,
+		); err != nil {
+			return nil, err
+		}
+		ayahs = append(ayahs, ay)
+	}
+
+	return ayahs, rows.Err()
+}
+
 func (a *AyahRepository) FindBySurah(ctx context.Context, surahID, from, to int) ([]ayah.Ayah, error) {
 	var (
 		query string
@@ -108,6 +153,41 @@ func (a *AyahRepository) FindBySurah(ctx context.Context, surahID, from, to int)
 	return ayahs, nil
 }
 
+func (a *AyahRepository) FindByIDRange(ctx context.Context, fromID, toID, limit, offset int) ([]ayah.Ayah, error) {
+	query := `SELECT id, surah_id, number_in_surah, text_uthmani,
+		translation_indo, translation_en, juz_number, sajda_type, revelation_type
+		FROM ayahs WHERE id BETWEEN ? AND ?
+		ORDER BY id ASC
+		LIMIT ? OFFSET ?`
+
+	rows, err := a.db.QueryContext(ctx, query, fromID, toID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ayahs []ayah.Ayah
+	for rows.Next() {
+		var ay ayah.Ayah
+		if err := rows.Scan(
+			&ay.ID,
+			&ay.SurahID,
+			&ay.NumberInSurah,
+			&ay.TextUthmani,
+			&ay.TranslationIdo,
+			&ay.TranslationEn,
+			&ay.JuzNumber,
+			&ay.SajdaType,
+			&ay.RevelationType,
+		); err != nil {
+			return nil, err
+		}
+		ayahs = append(ayahs, ay)
+	}
+
+	return ayahs, rows.Err()
+}
+
 func (a *AyahRepository) FindBySurahAndNumber(ctx context.Context, surahID, number int) (*ayah.Ayah, error) {
 	query := `SELECT 
 	id, 
@@ -246,3 +326,79 @@ func (a *AyahRepository) FindSajda(ctx context.Context) ([]ayah.SajdaAyah, error
 	}
 	return result, rows.Err()
 }
+
+// sortColumns maps each ayah.Sort to its ORDER BY clause, so the column
+// name never comes from the request.
+var sortColumns = map[ayah.Sort]string{
+	ayah.SortMushaf:      "id ASC",
+	ayah.SortMushafDesc:  "id DESC",
+	ayah.SortWords:       "word_count ASC, id ASC",
+	ayah.SortWordsDesc:   "word_count DESC, id ASC",
+	ayah.SortLetters:     "letter_count ASC, id ASC",
+	ayah.SortLettersDesc: "letter_count DESC, id ASC",
+}
+
+func (a *AyahRepository) FindByFilter(ctx context.Context, f ayah.Filter) ([]ayah.Ayah, int, error) {
+	var w where
+	if f.SurahID > 0 {
+		w.add("surah_id = ?", f.SurahID)
+	}
+	if f.Juz > 0 {
+		w.add("juz_number = ?", f.Juz)
+	}
+	if f.Revelation != "" {
+		w.add("LOWER(revelation_type) = LOWER(?)", f.Revelation)
+	}
+	if f.Sajda != nil {
+		if *f.Sajda {
+			w.add("sajda_type IS NOT NULL")
+		} else {
+			w.add("sajda_type IS NULL")
+		}
+	}
+	if f.MinWords > 0 {
+		w.add("word_count >= ?", f.MinWords)
+	}
+	order, ok := sortColumns[f.Sort]
+	if !ok {
+		order = sortColumns[ayah.SortMushaf]
+	}
+
+	var total int
+	if err := a.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM ayahs`+w.String(), w.args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT id, surah_id, number_in_surah, text_uthmani,
+		translation_indo, translation_en, juz_number, sajda_type, revelation_type
+		FROM ayahs` + w.String() + `
+		ORDER BY ` + order + `
+		LIMIT ? OFFSET ?`
+
+	rows, err := a.db.QueryContext(ctx, query, append(w.args, f.Limit, f.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	ayahs := []ayah.Ayah{}
+	for rows.Next() {
+		var ay ayah.Ayah
+		if err := rows.Scan(
+			&ay.ID,
+			&ay.SurahID,
+			&ay.NumberInSurah,
+			&ay.TextUthmani,
+			&ay.TranslationIdo,
+			&ay.TranslationEn,
+			&ay.JuzNumber,
+			&ay.SajdaType,
+			&ay.RevelationType,
+		); err != nil {
+			return nil, 0, err
+		}
+		ayahs = append(ayahs, ay)
+	}
+
+	return ayahs, total, rows.Err()
+}
@@ -2,7 +2,9 @@ package repository_test
 
 import (
 	"context"
+	"quran-api-go/internal/domain/ayah"
 	"quran-api-go/internal/repository"
+	"reflect"
 	"testing"
 )
 
@@ -166,3 +168,93 @@ func TestAyahRepository_FindByID_NotFound(t *testing.T) {
 		t.Fatal("expected nil, got data")
 	}
 }
+
+func TestAyahRepository_FindByIDs_Success(t *testing.T) {
+	db := setupTestDB(t, createTableAyah, seedTableAyah)
+	repo := repository.NewAyahRepository(db)
+
+	ctx := context.Background()
+
+	ayahs, err := repo.FindByIDs(ctx, []int{7, 2, 999})
+	if err != nil {
+		t.Fatalf("failed to get ayahs %v", err)
+	}
+
+	if len(ayahs) != 2 {
+		t.Fatalf("expected 2 ayahs, got %d", len(ayahs))
+	}
+
+	if ayahs[0].ID != 2 || ayahs[1].ID != 7 {
+		t.Fatalf("expected ayahs 2 and 7, got %d and %d", ayahs[0].ID, ayahs[1].ID)
+	}
+}
+
+func TestAyahRepository_FindByIDRange_Success(t *testing.T) {
+	db := setupTestDB(t, createTableAyah, seedTableAyah)
+	repo := repository.NewAyahRepository(db)
+
+	ctx := context.Background()
+
+	ayahs, err := repo.FindByIDRange(ctx, 2, 6, 2, 1)
+	if err != nil {
+		t.Fatalf("failed to get ayahs %v", err)
+	}
+
+	if len(ayahs) != 2 {
+		t.Fatalf("expected 2 ayahs, got %d", len(ayahs))
+	}
+
+	if ayahs[0].ID != 3 || ayahs[1].ID != 4 {
+		t.Fatalf("expected ayahs 3 and 4, got %d and %d", ayahs[0].ID, ayahs[1].ID)
+	}
+}
+
+// seedAyahFilters gives the ayahs of seedTableAyah varied values to filter
+// on: ayah 5 is a sajda, ayahs 6 and 7 are Medinan and in juz 2.
+var seedAyahFilters = `
+UPDATE ayahs SET sajda_type = CASE id WHEN 5 THEN 'recommended' ELSE NULL END;
+UPDATE ayahs SET juz_number = 2, revelation_type = 'Medinan' WHERE id >= 6;
+`
+
+func TestAyahRepository_FindByFilter(t *testing.T) {
+	db := setupTestDB(t, createTableAyah+alterTableAyahDivisions, seedTableAyah)
+	if _, err := db.Exec(seedAyahDivisions + seedAyahFilters); err != nil {
+		t.Fatal(err)
+	}
+	repo := repository.NewAyahRepository(db)
+	yes, no := true, false
+
+	tests := []struct {
+		name      string
+		filter    ayah.Filter
+		wantIDs   []int
+		wantTotal int
+	}{
+		{"no filter", ayah.Filter{Limit: 3}, []int{1, 2, 3}, 7},
+		{"offset", ayah.Filter{Limit: 3, Offset: 5}, []int{6, 7}, 7},
+		{"surah", ayah.Filter{SurahID: 2, Limit: 20}, []int{}, 0},
+		{"juz and revelation", ayah.Filter{Juz: 2, Revelation: "medinan", Limit: 20}, []int{6, 7}, 2},
+		{"revelation ignores case", ayah.Filter{Revelation: "MECCAN", Limit: 20}, []int{1, 2, 3, 4, 5}, 5},
+		{"sajda", ayah.Filter{Sajda: &yes, Limit: 20}, []int{5}, 1},
+		{"no sajda", ayah.Filter{Sajda: &no, Juz: 1, Limit: 20}, []int{1, 2, 3, 4}, 4},
+		{"min words", ayah.Filter{MinWords: 7, Limit: 20}, []int{6, 7}, 2},
+		{"sort by words descending", ayah.Filter{Sort: ayah.SortWordsDesc, Limit: 2}, []int{7, 6}, 7},
+		{"unknown sort falls back to mushaf order", ayah.Filter{Sort: "id; DROP TABLE ayahs", Limit: 2}, []int{1, 2}, 7},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ayahs, total, err := repo.FindByFilter(context.Background(), tc.filter)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids := make([]int, len(ayahs))
+			for i, ay := range ayahs {
+				ids[i] = ay.ID
+			}
+			if !reflect.DeepEqual(ids, tc.wantIDs) || total != tc.wantTotal {
+				t.Errorf("got %v (total %d), want %v (total %d)", ids, total, tc.wantIDs, tc.wantTotal)
+			}
+		})
+	}
+}
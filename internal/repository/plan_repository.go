@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"quran-api-go/internal/domain/plan"
+)
+
+type planRepository struct {
+	db *sql.DB
+}
+
+func NewPlanRepository(db *sql.DB) plan.PlanRepository {
+	return &planRepository{db: db}
+}
+
+func (r *planRepository) FindAyahWeights(ctx context.Context) ([]plan.AyahWeight, error) {
+	query := `
+		SELECT id, surah_id, number_in_surah, juz_number,
+		       page_number, ruku_number, word_count, letter_count, recitation_ms
+		FROM ayahs
+		ORDER BY id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	weights := make([]plan.AyahWeight, 0, 6236)
+	for rows.Next() {
+		var w plan.AyahWeight
+		if err := rows.Scan(&w.ID, &w.SurahID, &w.NumberInSurah, &w.JuzNumber,
+			&w.PageNumber, &w.RukuNumber, &w.Words, &w.Letters, &w.RecitationMS); err != nil {
+			return nil, err
+		}
+		weights = append(weights, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return weights, nil
+}
@@ -0,0 +1,46 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"quran-api-go/internal/domain/plan"
+	"quran-api-go/internal/repository"
+)
+
+var alterTableAyahDivisions = `
+ALTER TABLE ayahs ADD COLUMN page_number INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ayahs ADD COLUMN ruku_number INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ayahs ADD COLUMN word_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ayahs ADD COLUMN letter_count INTEGER NOT NULL DEFAULT 0;
+`
+
+var seedAyahDivisions = `
+UPDATE ayahs SET page_number = 1, ruku_number = 1, word_count = id + 1, letter_count = id * 10;
+`
+
+func TestPlanRepository_FindAyahWeights(t *testing.T) {
+	db := setupTestDB(t, createTableAyah+alterTableAyahDivisions+createTableStats, seedTableAyah)
+	if _, err := db.Exec(seedAyahDivisions); err != nil {
+		t.Fatal(err)
+	}
+	repo := repository.NewPlanRepository(db)
+
+	weights, err := repo.FindAyahWeights(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(weights) == 0 {
+		t.Fatal("expected ayah weights")
+	}
+
+	want := plan.AyahWeight{ID: 2, SurahID: 1, NumberInSurah: 2, JuzNumber: 1, PageNumber: 1, RukuNumber: 1, Words: 3, Letters: 20}
+	if weights[1] != want {
+		t.Errorf("expected %+v, got %+v", want, weights[1])
+	}
+	for i, w := range weights {
+		if w.ID != i+1 {
+			t.Fatalf("weights out of mushaf order at %d: %+v", i, w)
+		}
+	}
+}
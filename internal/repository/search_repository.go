@@ -99,7 +99,16 @@ func (r *searchRepository) Search(ctx context.Context, p search.Params) ([]searc
 		r.SurahInfo.ID = r.SurahID
 
 		// Set translation based on lang
-		if p.Lang == "en" {
+		if len(p.Langs) > 1 {
+			r.Translations = make(map[string]string, len(p.Langs))
+			for _, lang := range p.Langs {
+				if lang == "en" {
+					r.Translations[lang] = translationEn
+				} else {
+					r.Translations[lang] = translationIndo
+				}
+			}
+		} else if p.Lang == "en" {
 			r.Translation = translationEn
 		} else {
 			r.Translation = translationIndo
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/stats"
+)
+
+type statsRepository struct {
+	db *sql.DB
+}
+
+func NewStatsRepository(db *sql.DB) stats.StatsRepository {
+	return &statsRepository{db: db}
+}
+
+const statsColumns = `scope, number, first_ayah_id, last_ayah_id, ayahs, words, letters, characters, recitation_ms, letters_before`
+
+func scanStats(row interface{ Scan(...any) error }) (stats.Stats, error) {
+	var s stats.Stats
+	err := row.Scan(&s.Scope, &s.Number, &s.FirstAyahID, &s.LastAyahID, &s.Ayahs, &s.Words,
+		&s.Letters, &s.Characters, &s.RecitationMS, &s.LettersBefore)
+	return s, err
+}
+
+func (r *statsRepository) FindByScope(ctx context.Context, scope stats.Scope) ([]stats.Stats, error) {
+	query := `SELECT ` + statsColumns + ` FROM stats WHERE scope = ? ORDER BY number ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, scope)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []stats.Stats
+	for rows.Next() {
+		s, err := scanStats(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *statsRepository) FindOne(ctx context.Context, scope stats.Scope, number int) (*stats.Stats, error) {
+	query := `SELECT ` + statsColumns + ` FROM stats WHERE scope = ? AND number = ?`
+
+	s, err := scanStats(r.db.QueryRowContext(ctx, query, scope, number))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (r *statsRepository) FindAyahs(ctx context.Context, fromID, toID int) ([]stats.AyahStats, error) {
+	query := `
+		SELECT id, surah_id, number_in_surah, word_count, letter_count, char_count, recitation_ms
+		FROM ayahs
+		WHERE id BETWEEN ? AND ?
+		ORDER BY id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, fromID, toID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []stats.AyahStats
+	for rows.Next() {
+		var a stats.AyahStats
+		if err := rows.Scan(&a.ID, &a.SurahID, &a.NumberInSurah, &a.Words, &a.Letters, &a.Characters, &a.RecitationMS); err != nil {
+			return nil, err
+		}
+		result = append(result, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,75 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/stats"
+	"quran-api-go/internal/repository"
+)
+
+var createTableStats = `
+ALTER TABLE ayahs ADD COLUMN char_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ayahs ADD COLUMN recitation_ms INTEGER NOT NULL DEFAULT 0;
+CREATE TABLE stats (
+	scope TEXT NOT NULL,
+	number INTEGER NOT NULL,
+	first_ayah_id INTEGER NOT NULL,
+	last_ayah_id INTEGER NOT NULL,
+	ayahs INTEGER NOT NULL,
+	words INTEGER NOT NULL,
+	letters INTEGER NOT NULL,
+	characters INTEGER NOT NULL,
+	recitation_ms INTEGER NOT NULL,
+	letters_before INTEGER NOT NULL,
+	PRIMARY KEY (scope, number)
+);
+`
+
+var seedTableStats = `
+UPDATE ayahs SET word_count = 4, letter_count = 19, char_count = 38, recitation_ms = 6222 WHERE id = 1;
+INSERT INTO stats VALUES
+('surah', 2, 8, 293, 286, 6144, 25613, 51774, 6264000, 139),
+('surah', 1, 1, 7, 7, 29, 139, 270, 44889, 0),
+('mushaf', 0, 1, 6236, 6236, 77430, 325000, 650000, 86400000, 0);
+`
+
+func TestStatsRepository(t *testing.T) {
+	db := setupTestDB(t, createTableAyah+alterTableAyahDivisions+createTableStats, seedTableAyah)
+	if _, err := db.Exec(seedTableStats); err != nil {
+		t.Fatal(err)
+	}
+	repo := repository.NewStatsRepository(db)
+	ctx := context.Background()
+
+	surahs, err := repo.FindByScope(ctx, stats.ScopeSurah)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(surahs) != 2 || surahs[0].Number != 1 || surahs[1].LettersBefore != 139 {
+		t.Errorf("unexpected surah stats: %+v", surahs)
+	}
+
+	mushaf, err := repo.FindOne(ctx, stats.ScopeMushaf, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mushaf.Scope != stats.ScopeMushaf || mushaf.Ayahs != 6236 || mushaf.RecitationMS != 86400000 {
+		t.Errorf("unexpected mushaf stats: %+v", mushaf)
+	}
+
+	if _, err := repo.FindOne(ctx, stats.ScopeJuz, 1); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	ayahs, err := repo.FindAyahs(ctx, 1, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := stats.AyahStats{ID: 1, SurahID: 1, NumberInSurah: 1, Words: 4, Letters: 19, Characters: 38, RecitationMS: 6222}
+	if len(ayahs) != 2 || ayahs[0] != want {
+		t.Errorf("unexpected ayah stats: %+v", ayahs)
+	}
+}
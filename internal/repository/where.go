@@ -0,0 +1,25 @@
+package repository
+
+import "strings"
+
+// where builds a WHERE clause from optional conditions. Conditions are
+// fixed SQL written by the caller; every value goes in args and is bound as
+// a parameter, never spliced into the query.
+type where struct {
+	conds []string
+	args  []interface{}
+}
+
+// add appends a condition with one ? per arg.
+func (w *where) add(cond string, args ...interface{}) {
+	w.conds = append(w.conds, cond)
+	w.args = append(w.args, args...)
+}
+
+// String returns " WHERE a AND b", or "" without conditions.
+func (w *where) String() string {
+	if len(w.conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(w.conds, " AND ")
+}
@@ -21,10 +21,54 @@ func (s *ayahService) GetByID(ctx context.Context, id int) (*ayah.Ayah, error) {
 	return s.repo.FindByID(ctx, id)
 }
 
+func (s *ayahService) GetByIDs(ctx context.Context, ids []int) ([]ayah.Ayah, error) {
+	unique := make([]int, 0, len(ids))
+	seen := make(map[int]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	found, err := s.repo.FindByIDs(ctx, unique)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]ayah.Ayah, len(found))
+	for _, a := range found {
+		byID[a.ID] = a
+	}
+
+	// Keep the caller's order (including duplicates) and skip unknown IDs.
+	result := make([]ayah.Ayah, 0, len(ids))
+	for _, id := range ids {
+		if a, ok := byID[id]; ok {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
 func (s *ayahService) GetBySurah(ctx context.Context, surahID, from, to int) ([]ayah.Ayah, error) {
 	return s.repo.FindBySurah(ctx, surahID, from, to)
 }
 
+func (s *ayahService) GetByIDRange(ctx context.Context, fromID, toID, limit, offset int) ([]ayah.Ayah, error) {
+	if fromID > toID {
+		return nil, nil
+	}
+	if limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	return s.repo.FindByIDRange(ctx, fromID, toID, limit, offset)
+}
+
 func (s *ayahService) GetBySurahAndNumber(ctx context.Context, surahID, number int) (*ayah.Ayah, error) {
 	return s.repo.FindBySurahAndNumber(ctx, surahID, number)
 }
@@ -36,3 +80,7 @@ func (s *ayahService) GetRandom(ctx context.Context, surahID int) (*ayah.Ayah, e
 func (s *ayahService) GetSajda(ctx context.Context) ([]ayah.SajdaAyah, error) {
 	return s.repo.FindSajda(ctx)
 }
+
+func (s *ayahService) Query(ctx context.Context, f ayah.Filter) ([]ayah.Ayah, int, error) {
+	return s.repo.FindByFilter(ctx, f)
+}
@@ -10,6 +10,7 @@ import (
 )
 
 type MockAyahRepository struct {
+	FindByIDsFunc            func(ctx context.Context, ids []int) ([]ayah.Ayah, error)
 	FindBySurahAndNumberFunc func(ctx context.Context, surahID, number int) (*ayah.Ayah, error)
 }
 
@@ -17,10 +18,21 @@ func (m *MockAyahRepository) FindByID(ctx context.Context, id int) (*ayah.Ayah,
 	return nil, nil
 }
 
+func (m *MockAyahRepository) FindByIDs(ctx context.Context, ids []int) ([]ayah.Ayah, error) {
+	if m.FindByIDsFunc != nil {
+		return m.FindByIDsFunc(ctx, ids)
+	}
+	return nil, nil
+}
+
 func (m *MockAyahRepository) FindBySurah(ctx context.Context, surahID, from, to int) ([]ayah.Ayah, error) {
 	return nil, nil
 }
 
+func (m *MockAyahRepository) FindByIDRange(ctx context.Context, fromID, toID, limit, offset int) ([]ayah.Ayah, error) {
+	return nil, nil
+}
+
 func (m *MockAyahRepository) FindBySurahAndNumber(ctx context.Context, surahID, number int) (*ayah.Ayah, error) {
 	if m.FindBySurahAndNumberFunc != nil {
 		return m.FindBySurahAndNumberFunc(ctx, surahID, number)
@@ -32,6 +44,14 @@ func (m *MockAyahRepository) FindRandom(ctx context.Context, surahID int) (*ayah
 	return nil, nil
 }
 
+func (m *MockAyahRepository) FindSajda(ctx context.Context) ([]ayah.SajdaAyah, error) {
+	return nil, nil
+}
+
+func (m *MockAyahRepository) FindByFilter(ctx context.Context, f ayah.Filter) ([]ayah.Ayah, int, error) {
+	return nil, 0, nil
+}
+
 func TestAyahService_GetBySurahAndNumber(t *testing.T) {
 	ctx := context.Background()
 
@@ -97,3 +117,32 @@ func TestAyahService_GetBySurahAndNumber(t *testing.T) {
 		}
 	})
 }
+
+func TestAyahService_GetByIDs(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := &MockAyahRepository{
+		FindByIDsFunc: func(ctx context.Context, ids []int) ([]ayah.Ayah, error) {
+			if len(ids) != 3 {
+				t.Errorf("expected duplicates to be removed, got %v", ids)
+			}
+			return []ayah.Ayah{{ID: 1}, {ID: 5}, {ID: 9}}, nil
+		},
+	}
+
+	ayahService := service.NewAyahService(mockRepo)
+	ayahs, err := ayahService.GetByIDs(ctx, []int{9, 1, 9, 5})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []int{9, 1, 9, 5}
+	if len(ayahs) != len(want) {
+		t.Fatalf("expected %d ayahs, got %d", len(want), len(ayahs))
+	}
+	for i, id := range want {
+		if ayahs[i].ID != id {
+			t.Errorf("position %d: expected id %d, got %d", i, id, ayahs[i].ID)
+		}
+	}
+}
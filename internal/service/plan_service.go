@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/plan"
+	"quran-api-go/pkg/khatam"
+)
+
+type planService struct {
+	repo plan.PlanRepository
+}
+
+// NewPlanService creates a new instance of PlanService
+func NewPlanService(repo plan.PlanRepository) plan.PlanService {
+	return &planService{repo: repo}
+}
+
+// block is a run of ayahs, weights[start:end], that no portion may split.
+type block struct {
+	start, end int
+	weight     int
+}
+
+func (s *planService) Khatam(ctx context.Context, days int, unit plan.Unit, measure plan.Measure) ([]plan.Portion, error) {
+	if days < 1 || days > unit.Size() {
+		return nil, domain.ErrInvalidRangeParam
+	}
+
+	weights, err := s.repo.FindAyahWeights(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(weights) == 0 {
+		return nil, domain.ErrNotFound
+	}
+
+	weigh := func(w plan.AyahWeight) int { return 1 }
+	switch measure {
+	case plan.MeasureBalanced:
+		weigh = func(w plan.AyahWeight) int { return w.Letters }
+	case plan.MeasureWords:
+		weigh = func(w plan.AyahWeight) int { return w.Words }
+	}
+	if weigh(weights[0]) == 0 {
+		return nil, fmt.Errorf("%w: %s counts", domain.ErrDatasetIncomplete, measure)
+	}
+
+	var blocks []block
+	switch unit {
+	case plan.UnitJuz:
+		blocks = groupBy(weights, weigh, func(w plan.AyahWeight) int { return w.JuzNumber })
+	case plan.UnitPage:
+		if weights[0].PageNumber == 0 {
+			return nil, fmt.Errorf("%w: page numbers", domain.ErrDatasetIncomplete)
+		}
+		blocks = groupBy(weights, weigh, func(w plan.AyahWeight) int { return w.PageNumber })
+	default:
+		// Break between rukus so no day stops mid-passage, unless there
+		// are more days than rukus or the dataset has none.
+		if weights[0].RukuNumber != 0 {
+			blocks = groupBy(weights, weigh, func(w plan.AyahWeight) int { return w.RukuNumber })
+		}
+		if len(blocks) < days {
+			blocks = groupBy(weights, weigh, func(w plan.AyahWeight) int { return w.ID })
+		}
+	}
+	if len(blocks) < days {
+		return nil, domain.ErrInvalidRangeParam
+	}
+
+	blockWeights := make([]int, len(blocks))
+	for i, b := range blocks {
+		blockWeights[i] = b.weight
+	}
+
+	portions := make([]plan.Portion, 0, days)
+	prev := 0
+	for _, end := range khatam.Split(blockWeights, days) {
+		portions = append(portions, newPortion(weights[blocks[prev].start:blocks[end-1].end]))
+		prev = end
+	}
+	return portions, nil
+}
+
+// groupBy gathers consecutive ayahs sharing key into blocks.
+func groupBy(weights []plan.AyahWeight, weigh func(plan.AyahWeight) int, key func(plan.AyahWeight) int) []block {
+	var blocks []block
+	for i, w := range weights {
+		if i == 0 || key(w) != key(weights[i-1]) {
+			blocks = append(blocks, block{start: i, end: i})
+		}
+		b := &blocks[len(blocks)-1]
+		b.end = i + 1
+		b.weight += weigh(w)
+	}
+	return blocks
+}
+
+func newPortion(ayahs []plan.AyahWeight) plan.Portion {
+	first, last := ayahs[0], ayahs[len(ayahs)-1]
+	p := plan.Portion{
+		FromID:    first.ID,
+		ToID:      last.ID,
+		FromSurah: first.SurahID,
+		FromAyah:  first.NumberInSurah,
+		ToSurah:   last.SurahID,
+		ToAyah:    last.NumberInSurah,
+		FromPage:  first.PageNumber,
+		ToPage:    last.PageNumber,
+		Ayahs:     len(ayahs),
+	}
+	for _, a := range ayahs {
+		p.Words += a.Words
+		p.Letters += a.Letters
+		p.RecitationMS += a.RecitationMS
+	}
+	return p
+}
@@ -0,0 +1,147 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/plan"
+	"quran-api-go/internal/service"
+	"quran-api-go/pkg/reference"
+)
+
+type MockPlanRepository struct {
+	FindAyahWeightsFunc func(ctx context.Context) ([]plan.AyahWeight, error)
+}
+
+func (m *MockPlanRepository) FindAyahWeights(ctx context.Context) ([]plan.AyahWeight, error) {
+	if m.FindAyahWeightsFunc != nil {
+		return m.FindAyahWeightsFunc(ctx)
+	}
+	return nil, nil
+}
+
+// fakeMushaf lays out every ayah with synthetic divisions: 30 equal juz,
+// 604 equal pages and a ruku every 11 ayahs.
+func fakeMushaf(withPages bool) []plan.AyahWeight {
+	weights := make([]plan.AyahWeight, reference.TotalAyahs)
+	for i := range weights {
+		id := i + 1
+		surahID, number, _ := reference.Locate(id)
+		weights[i] = plan.AyahWeight{
+			ID:            id,
+			SurahID:       surahID,
+			NumberInSurah: number,
+			JuzNumber:     i*30/reference.TotalAyahs + 1,
+			RukuNumber:    i/11 + 1,
+			Words:         5 + i%13,
+			Letters:       20 + (i*31)%90,
+		}
+		if withPages {
+			weights[i].PageNumber = i*604/reference.TotalAyahs + 1
+		}
+	}
+	return weights
+}
+
+func TestPlanService_Khatam(t *testing.T) {
+	ctx := context.Background()
+	svc := service.NewPlanService(&MockPlanRepository{
+		FindAyahWeightsFunc: func(ctx context.Context) ([]plan.AyahWeight, error) {
+			return fakeMushaf(true), nil
+		},
+	})
+
+	checkCovers := func(t *testing.T, portions []plan.Portion, days int) {
+		t.Helper()
+		if len(portions) != days {
+			t.Fatalf("expected %d portions, got %d", days, len(portions))
+		}
+		next := 1
+		for i, p := range portions {
+			if p.FromID != next || p.ToID < p.FromID || p.Ayahs != p.ToID-p.FromID+1 {
+				t.Fatalf("portion %d = %+v does not continue from ayah %d", i+1, p, next)
+			}
+			next = p.ToID + 1
+		}
+		if next != reference.TotalAyahs+1 {
+			t.Fatalf("plan stops at ayah %d", next-1)
+		}
+	}
+
+	t.Run("One juz a day", func(t *testing.T) {
+		portions, err := svc.Khatam(ctx, 30, plan.UnitJuz, plan.MeasureBalanced)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkCovers(t, portions, 30)
+		for i, p := range portions {
+			if want := i*reference.TotalAyahs/30 + 1; p.FromID < want || p.FromID > want+1 {
+				t.Errorf("day %d starts at ayah %d, not at juz %d", i+1, p.FromID, i+1)
+			}
+		}
+	})
+
+	t.Run("Ayah unit ends on rukus", func(t *testing.T) {
+		portions, err := svc.Khatam(ctx, 29, plan.UnitAyah, plan.MeasureWords)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkCovers(t, portions, 29)
+		for i, p := range portions[:28] {
+			if p.ToID%11 != 0 {
+				t.Errorf("day %d ends mid-ruku at ayah %d", i+1, p.ToID)
+			}
+		}
+	})
+
+	t.Run("More days than rukus splits ayahs", func(t *testing.T) {
+		portions, err := svc.Khatam(ctx, 1000, plan.UnitAyah, plan.MeasureAyahs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkCovers(t, portions, 1000)
+	})
+
+	t.Run("Pages", func(t *testing.T) {
+		portions, err := svc.Khatam(ctx, 604, plan.UnitPage, plan.MeasureBalanced)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkCovers(t, portions, 604)
+		if portions[9].FromPage != 10 || portions[9].ToPage != 10 {
+			t.Errorf("day 10 = %+v, want page 10", portions[9])
+		}
+	})
+
+	t.Run("Days out of range", func(t *testing.T) {
+		for _, days := range []int{0, 31} {
+			if _, err := svc.Khatam(ctx, days, plan.UnitJuz, plan.MeasureBalanced); !errors.Is(err, domain.ErrInvalidRangeParam) {
+				t.Errorf("days=%d: expected ErrInvalidRangeParam, got %v", days, err)
+			}
+		}
+	})
+
+	t.Run("Dataset without pages", func(t *testing.T) {
+		svc := service.NewPlanService(&MockPlanRepository{
+			FindAyahWeightsFunc: func(ctx context.Context) ([]plan.AyahWeight, error) {
+				return fakeMushaf(false), nil
+			},
+		})
+		if _, err := svc.Khatam(ctx, 30, plan.UnitPage, plan.MeasureBalanced); !errors.Is(err, domain.ErrDatasetIncomplete) {
+			t.Errorf("expected ErrDatasetIncomplete, got %v", err)
+		}
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		svc := service.NewPlanService(&MockPlanRepository{
+			FindAyahWeightsFunc: func(ctx context.Context) ([]plan.AyahWeight, error) {
+				return nil, errors.New("db error")
+			},
+		})
+		if _, err := svc.Khatam(ctx, 30, plan.UnitJuz, plan.MeasureBalanced); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
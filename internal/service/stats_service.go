@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/stats"
+)
+
+type statsService struct {
+	repo stats.StatsRepository
+}
+
+// NewStatsService creates a new instance of StatsService
+func NewStatsService(repo stats.StatsRepository) stats.StatsService {
+	return &statsService{repo: repo}
+}
+
+func (s *statsService) GetOverview(ctx context.Context) (*stats.Overview, error) {
+	mushaf, err := s.mushaf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	overview := &stats.Overview{Counts: counts(*mushaf)}
+	for _, scope := range []stats.Scope{stats.ScopeSurah, stats.ScopeJuz} {
+		rows, err := s.repo.FindByScope(ctx, scope)
+		if err != nil {
+			return nil, err
+		}
+		parts := make([]stats.Part, len(rows))
+		for i, row := range rows {
+			parts[i] = part(row, mushaf.Letters)
+		}
+		if scope == stats.ScopeSurah {
+			overview.Surahs = parts
+		} else {
+			overview.Juzs = parts
+		}
+	}
+	return overview, nil
+}
+
+func (s *statsService) GetSurah(ctx context.Context, id int) (*stats.Detail, error) {
+	if id < 1 || id > 114 {
+		return nil, nil
+	}
+	return s.detail(ctx, stats.ScopeSurah, id)
+}
+
+func (s *statsService) GetJuz(ctx context.Context, number int) (*stats.Detail, error) {
+	if number < 1 || number > 30 {
+		return nil, nil
+	}
+	return s.detail(ctx, stats.ScopeJuz, number)
+}
+
+// mushaf loads the whole-mushaf row, which every percentage is relative
+// to. Its absence means the dataset was seeded before statistics existed.
+func (s *statsService) mushaf(ctx context.Context) (*stats.Stats, error) {
+	mushaf, err := s.repo.FindOne(ctx, stats.ScopeMushaf, 0)
+	if errors.Is(err, domain.ErrNotFound) || (err == nil && mushaf.Letters == 0) {
+		return nil, domain.ErrDatasetIncomplete
+	}
+	return mushaf, err
+}
+
+func (s *statsService) detail(ctx context.Context, scope stats.Scope, number int) (*stats.Detail, error) {
+	mushaf, err := s.mushaf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.repo.FindOne(ctx, scope, number)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, domain.ErrDatasetIncomplete
+	}
+	if err != nil {
+		return nil, err
+	}
+	ayahs, err := s.repo.FindAyahs(ctx, row.FirstAyahID, row.LastAyahID)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &stats.Detail{
+		Part:        part(*row, mushaf.Letters),
+		FirstAyahID: row.FirstAyahID,
+		LastAyahID:  row.LastAyahID,
+		PerAyah:     make([]stats.AyahProgress, len(ayahs)),
+	}
+	read := row.LettersBefore
+	for i, a := range ayahs {
+		read += a.Letters
+		detail.PerAyah[i] = stats.AyahProgress{
+			ID:                a.ID,
+			SurahID:           a.SurahID,
+			NumberInSurah:     a.NumberInSurah,
+			Words:             a.Words,
+			Letters:           a.Letters,
+			Characters:        a.Characters,
+			RecitationSeconds: seconds(a.RecitationMS),
+			Progress:          percent(read, mushaf.Letters),
+		}
+	}
+	return detail, nil
+}
+
+func counts(row stats.Stats) stats.Counts {
+	return stats.Counts{
+		Ayahs:             row.Ayahs,
+		Words:             row.Words,
+		Letters:           row.Letters,
+		Characters:        row.Characters,
+		RecitationSeconds: seconds(row.RecitationMS),
+	}
+}
+
+func part(row stats.Stats, total int) stats.Part {
+	return stats.Part{
+		Number:   row.Number,
+		Counts:   counts(row),
+		Percent:  percent(row.Letters, total),
+		Progress: percent(row.LettersBefore+row.Letters, total),
+	}
+}
+
+func seconds(ms int) int {
+	return (ms + 500) / 1000
+}
+
+// percent is n as a percentage of total, to three decimals.
+func percent(n, total int) float64 {
+	return math.Round(float64(n)*100000/float64(total)) / 1000
+}
@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/internal/domain/stats"
+	"quran-api-go/internal/service"
+)
+
+type MockStatsRepository struct {
+	FindByScopeFunc func(ctx context.Context, scope stats.Scope) ([]stats.Stats, error)
+	FindOneFunc     func(ctx context.Context, scope stats.Scope, number int) (*stats.Stats, error)
+	FindAyahsFunc   func(ctx context.Context, fromID, toID int) ([]stats.AyahStats, error)
+}
+
+func (m *MockStatsRepository) FindByScope(ctx context.Context, scope stats.Scope) ([]stats.Stats, error) {
+	if m.FindByScopeFunc != nil {
+		return m.FindByScopeFunc(ctx, scope)
+	}
+	return nil, nil
+}
+
+func (m *MockStatsRepository) FindOne(ctx context.Context, scope stats.Scope, number int) (*stats.Stats, error) {
+	if m.FindOneFunc != nil {
+		return m.FindOneFunc(ctx, scope, number)
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *MockStatsRepository) FindAyahs(ctx context.Context, fromID, toID int) ([]stats.AyahStats, error) {
+	if m.FindAyahsFunc != nil {
+		return m.FindAyahsFunc(ctx, fromID, toID)
+	}
+	return nil, nil
+}
+
+// A two-surah mushaf of 1000 letters: surah 1 has 200, surah 2 has 800.
+var statsRows = map[stats.Scope][]stats.Stats{
+	stats.ScopeMushaf: {{Scope: stats.ScopeMushaf, FirstAyahID: 1, LastAyahID: 4, Ayahs: 4, Words: 100, Letters: 1000, Characters: 2000, RecitationMS: 230500}},
+	stats.ScopeSurah: {
+		{Scope: stats.ScopeSurah, Number: 1, FirstAyahID: 1, LastAyahID: 2, Ayahs: 2, Words: 20, Letters: 200, Characters: 400, RecitationMS: 48444},
+		{Scope: stats.ScopeSurah, Number: 2, FirstAyahID: 3, LastAyahID: 4, Ayahs: 2, Words: 80, Letters: 800, Characters: 1600, RecitationMS: 182056, LettersBefore: 200},
+	},
+	stats.ScopeJuz: {{Scope: stats.ScopeJuz, Number: 1, FirstAyahID: 1, LastAyahID: 4, Ayahs: 4, Words: 100, Letters: 1000, Characters: 2000, RecitationMS: 230500}},
+}
+
+func newStatsRepository() *MockStatsRepository {
+	return &MockStatsRepository{
+		FindByScopeFunc: func(ctx context.Context, scope stats.Scope) ([]stats.Stats, error) {
+			return statsRows[scope], nil
+		},
+		FindOneFunc: func(ctx context.Context, scope stats.Scope, number int) (*stats.Stats, error) {
+			for _, row := range statsRows[scope] {
+				if row.Number == number {
+					return &row, nil
+				}
+			}
+			return nil, domain.ErrNotFound
+		},
+		FindAyahsFunc: func(ctx context.Context, fromID, toID int) ([]stats.AyahStats, error) {
+			if fromID != 3 || toID != 4 {
+				return nil, errors.New("unexpected range")
+			}
+			return []stats.AyahStats{
+				{ID: 3, SurahID: 2, NumberInSurah: 1, Words: 30, Letters: 300, RecitationMS: 68667},
+				{ID: 4, SurahID: 2, NumberInSurah: 2, Words: 50, Letters: 500, RecitationMS: 113389},
+			}, nil
+		},
+	}
+}
+
+func TestStatsService_GetOverview(t *testing.T) {
+	svc := service.NewStatsService(newStatsRepository())
+
+	overview, err := svc.GetOverview(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if overview.Letters != 1000 || overview.RecitationSeconds != 231 {
+		t.Errorf("unexpected totals: %+v", overview.Counts)
+	}
+	if len(overview.Surahs) != 2 || len(overview.Juzs) != 1 {
+		t.Fatalf("unexpected parts: %+v", overview)
+	}
+	if s := overview.Surahs[1]; s.Percent != 80 || s.Progress != 100 {
+		t.Errorf("surah 2 = %+v, want 80%% of the mushaf ending at 100%%", s)
+	}
+}
+
+func TestStatsService_GetSurah(t *testing.T) {
+	ctx := context.Background()
+	svc := service.NewStatsService(newStatsRepository())
+
+	t.Run("Success", func(t *testing.T) {
+		detail, err := svc.GetSurah(ctx, 2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if detail.Number != 2 || detail.Percent != 80 || len(detail.PerAyah) != 2 {
+			t.Fatalf("unexpected detail: %+v", detail)
+		}
+		// 200 letters before the surah, then 300 in its first ayah.
+		if a := detail.PerAyah[0]; a.Progress != 50 || a.RecitationSeconds != 69 {
+			t.Errorf("unexpected first ayah: %+v", a)
+		}
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		if detail, err := svc.GetSurah(ctx, 115); detail != nil || err != nil {
+			t.Errorf("expected nil, nil; got %v, %v", detail, err)
+		}
+	})
+
+	t.Run("Dataset without statistics", func(t *testing.T) {
+		svc := service.NewStatsService(&MockStatsRepository{})
+		if _, err := svc.GetSurah(ctx, 1); !errors.Is(err, domain.ErrDatasetIncomplete) {
+			t.Errorf("expected ErrDatasetIncomplete, got %v", err)
+		}
+		if _, err := svc.GetOverview(ctx); !errors.Is(err, domain.ErrDatasetIncomplete) {
+			t.Errorf("expected ErrDatasetIncomplete, got %v", err)
+		}
+	})
+}
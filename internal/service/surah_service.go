@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"math"
 
 	"quran-api-go/internal/domain/surah"
+	"quran-api-go/pkg/reference"
 )
 
 type surahService struct {
@@ -15,13 +17,58 @@ func NewSurahService(repo surah.SurahRepository) surah.SurahService {
 }
 
 func (s *surahService) GetAll(ctx context.Context) ([]surah.Surah, error) {
-	return s.repo.FindAll(ctx)
+	surahs, err := s.repo.FindAll(ctx)
+	return withSlugs(surahs), err
 }
 
 func (s *surahService) GetByID(ctx context.Context, id int) (*surah.Surah, error) {
-	return s.repo.FindByID(ctx, id)
+	sur, err := s.repo.FindByID(ctx, id)
+	if sur != nil {
+		sur.Slug = reference.SurahSlug(sur.Number)
+	}
+	return sur, err
 }
 
 func (s *surahService) GetByRevelationType(ctx context.Context, revelationType string) ([]surah.Surah, error) {
-	return s.repo.FindByRevelationType(ctx, revelationType)
+	surahs, err := s.repo.FindByRevelationType(ctx, revelationType)
+	return withSlugs(surahs), err
+}
+
+// Search finds surahs by any spelling of their name (see
+// reference.SearchSurahs), best match first.
+func (s *surahService) Search(ctx context.Context, q string, limit int) ([]surah.Match, error) {
+	found := reference.SearchSurahs(q, limit)
+	if len(found) == 0 {
+		return []surah.Match{}, nil
+	}
+
+	surahs, err := s.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byNumber := make(map[int]surah.Surah, len(surahs))
+	for _, sur := range surahs {
+		byNumber[sur.Number] = sur
+	}
+
+	matches := make([]surah.Match, 0, len(found))
+	for _, f := range found {
+		if sur, ok := byNumber[f.Number]; ok {
+			matches = append(matches, surah.Match{
+				Surah:   sur,
+				Matched: f.Name,
+				Score:   math.Round(f.Score*1000) / 1000,
+			})
+		}
+	}
+	return matches, nil
+}
+
+// withSlugs fills in the URL slug, which is derived from the surah number
+// rather than stored.
+func withSlugs(surahs []surah.Surah) []surah.Surah {
+	for i := range surahs {
+		surahs[i].Slug = reference.SurahSlug(surahs[i].Number)
+	}
+	return surahs
 }
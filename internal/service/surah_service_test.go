@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"quran-api-go/internal/domain/surah"
+	"quran-api-go/internal/service"
+)
+
+type MockSurahRepository struct {
+	FindAllFunc func(ctx context.Context) ([]surah.Surah, error)
+}
+
+func (m *MockSurahRepository) FindAll(ctx context.Context) ([]surah.Surah, error) {
+	if m.FindAllFunc != nil {
+		return m.FindAllFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockSurahRepository) FindByID(ctx context.Context, id int) (*surah.Surah, error) {
+	return &surah.Surah{ID: id, Number: id}, nil
+}
+
+func (m *MockSurahRepository) FindByRevelationType(ctx context.Context, revelationType string) ([]surah.Surah, error) {
+	return nil, nil
+}
+
+func allSurahs(ctx context.Context) ([]surah.Surah, error) {
+	surahs := make([]surah.Surah, 114)
+	for i := range surahs {
+		surahs[i] = surah.Surah{ID: i + 1, Number: i + 1}
+	}
+	return surahs, nil
+}
+
+func TestSurahService_Slug(t *testing.T) {
+	svc := service.NewSurahService(&MockSurahRepository{FindAllFunc: allSurahs})
+
+	s, err := svc.GetByID(context.Background(), 2)
+	if err != nil || s.Slug != "al-baqarah" {
+		t.Errorf("GetByID(2) = %+v, %v", s, err)
+	}
+	all, err := svc.GetAll(context.Background())
+	if err != nil || all[35].Slug != "yasin" {
+		t.Errorf("GetAll()[35] = %+v, %v", all[35], err)
+	}
+}
+
+func TestSurahService_Search(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		svc := service.NewSurahService(&MockSurahRepository{FindAllFunc: allSurahs})
+
+		matches, err := svc.Search(ctx, "Yaasiin", 5)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(matches) == 0 || matches[0].Number != 36 || matches[0].Slug != "yasin" || matches[0].Score != 1 {
+			t.Errorf("unexpected matches %+v", matches)
+		}
+	})
+
+	t.Run("NoMatchSkipsRepository", func(t *testing.T) {
+		svc := service.NewSurahService(&MockSurahRepository{
+			FindAllFunc: func(ctx context.Context) ([]surah.Surah, error) {
+				t.Error("repository called for a query with no match")
+				return nil, nil
+			},
+		})
+
+		matches, err := svc.Search(ctx, "xyz", 5)
+		if err != nil || matches == nil || len(matches) != 0 {
+			t.Errorf("got %v, %v; want empty non-nil slice", matches, err)
+		}
+	})
+
+	t.Run("RepositoryError", func(t *testing.T) {
+		svc := service.NewSurahService(&MockSurahRepository{
+			FindAllFunc: func(ctx context.Context) ([]surah.Surah, error) {
+				return nil, errors.New("db error")
+			},
+		})
+
+		if _, err := svc.Search(ctx, "yasin", 5); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Where each ayah falls in the Madani mushaf's pages and in the rukus, and
+-- how long it is in words and letters of the Uthmani text. The seed fills
+-- these in; reading plans balance and break on them.
+ALTER TABLE ayahs ADD COLUMN page_number INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ayahs ADD COLUMN ruku_number INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ayahs ADD COLUMN word_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ayahs ADD COLUMN letter_count INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE ayahs DROP COLUMN letter_count;
+ALTER TABLE ayahs DROP COLUMN word_count;
+ALTER TABLE ayahs DROP COLUMN ruku_number;
+ALTER TABLE ayahs DROP COLUMN page_number;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Text statistics, computed by the seed from text_uthmani: per ayah on the
+-- ayahs table, and summed per surah, per juz and for the whole mushaf in
+-- stats. letters_before is the mushaf's letter count ahead of a stretch,
+-- so progress can be weighted by text rather than by ayah count.
+ALTER TABLE ayahs ADD COLUMN char_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ayahs ADD COLUMN recitation_ms INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS stats (
+	scope TEXT NOT NULL, -- 'mushaf', 'surah' or 'juz'
+	number INTEGER NOT NULL, -- surah or juz number; 0 for the mushaf
+	first_ayah_id INTEGER NOT NULL,
+	last_ayah_id INTEGER NOT NULL,
+	ayahs INTEGER NOT NULL,
+	words INTEGER NOT NULL,
+	letters INTEGER NOT NULL,
+	characters INTEGER NOT NULL,
+	recitation_ms INTEGER NOT NULL,
+	letters_before INTEGER NOT NULL,
+	PRIMARY KEY (scope, number)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS stats;
+ALTER TABLE ayahs DROP COLUMN recitation_ms;
+ALTER TABLE ayahs DROP COLUMN char_count;
@@ -0,0 +1,60 @@
+// Package arabic measures Uthmani Arabic text: its words, the letters they
+// are written with (ignoring diacritics and pause marks), and roughly how
+// long it takes to recite.
+package arabic
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Recitation pace used by RecitationTime: a measured murattal reading plus
+// a breath at the end of each ayah. It is an estimate for planning, not a
+// timing of any particular reciter.
+const (
+	lettersPerSecond = 4.5
+	ayahPause        = 2 * time.Second
+)
+
+// Words splits text on whitespace, dropping standalone pause and section
+// marks that carry no letters.
+func Words(text string) []string {
+	words := []string{}
+	for _, token := range strings.Fields(text) {
+		if strings.IndexFunc(token, unicode.IsLetter) >= 0 {
+			words = append(words, token)
+		}
+	}
+	return words
+}
+
+// Letters counts the base letters of text. Harakat, small high letters,
+// pause marks and the tatweel are not letters in their own right and are
+// skipped.
+func Letters(text string) int {
+	n := 0
+	for _, r := range text {
+		if unicode.Is(unicode.Lo, r) {
+			n++
+		}
+	}
+	return n
+}
+
+// Characters counts every code point of text other than whitespace,
+// diacritics and marks included.
+func Characters(text string) int {
+	n := 0
+	for _, r := range text {
+		if !unicode.IsSpace(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// RecitationTime estimates how long text takes to recite as one ayah.
+func RecitationTime(text string) time.Duration {
+	return time.Duration(float64(Letters(text))/lettersPerSecond*float64(time.Second)) + ayahPause
+}
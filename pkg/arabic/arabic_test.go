@@ -0,0 +1,53 @@
+package arabic
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWords(t *testing.T) {
+	words := Words("ذَٰلِكَ ٱلۡكِتَٰبُ لَا رَيۡبَۛ فِيهِۛ هُدٗى لِّلۡمُتَّقِينَ ۞ ۚ")
+	if len(words) != 7 {
+		t.Fatalf("Words = %q, want 7 words", words)
+	}
+	if words[0] != "ذَٰلِكَ" {
+		t.Errorf("Words[0] = %q", words[0])
+	}
+	if got := Words("  "); len(got) != 0 {
+		t.Errorf("Words(blank) = %q", got)
+	}
+}
+
+func TestLetters(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"بِسۡمِ ٱللَّهِ ٱلرَّحۡمَٰنِ ٱلرَّحِيمِ", 19},
+		{"ٱلۡحَمۡدُ لِلَّهِ", 8},
+		{"ـــ ۖ ۞", 0},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		if got := Letters(tt.text); got != tt.want {
+			t.Errorf("Letters(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestCharacters(t *testing.T) {
+	if got := Characters("لَا رَيۡبَۛ"); got != 10 {
+		t.Errorf("Characters = %d, want 10", got)
+	}
+}
+
+func TestRecitationTime(t *testing.T) {
+	// 19 letters at 4.5 a second, then the pause after the ayah.
+	got := RecitationTime("بِسۡمِ ٱللَّهِ ٱلرَّحۡمَٰنِ ٱلرَّحِيمِ")
+	if got.Round(time.Millisecond) != 6222*time.Millisecond {
+		t.Errorf("RecitationTime = %v", got)
+	}
+	if RecitationTime("") != 2*time.Second {
+		t.Errorf("RecitationTime(\"\") = %v", RecitationTime(""))
+	}
+}
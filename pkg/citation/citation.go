@@ -0,0 +1,90 @@
+// Package citation formats ayah references in the citation styles used in
+// Indonesian publications and academic writing.
+//
+// Usage:
+//
+//	text, err := citation.Format(citation.StyleKemenag, "id", reference.Span{SurahID: 2, From: 255, To: 255})
+//	// "QS. Al-Baqarah [2]: 255"
+package citation
+
+import (
+	"fmt"
+	"strconv"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/pkg/reference"
+)
+
+// Style is a citation style.
+type Style string
+
+const (
+	// StyleKemenag is the Indonesian Ministry of Religious Affairs style:
+	// "QS. Al-Baqarah [2]: 255".
+	StyleKemenag Style = "kemenag"
+	// StyleShort is the compact parenthetical form: "(Al-Baqarah 2:255)".
+	StyleShort Style = "short"
+	// StyleAPA is the APA 7 in-text form for classical religious works:
+	// "(The Qur'an, 2:255)".
+	StyleAPA Style = "apa"
+	// StyleChicago is the Chicago notes form: "Qur'an, Al-Baqara 2:255."
+	StyleChicago Style = "chicago"
+	// StyleTurabian is the Turabian parenthetical form: "(Qur'an 2:255)".
+	StyleTurabian Style = "turabian"
+)
+
+// Styles lists every supported style, default first.
+var Styles = []Style{StyleKemenag, StyleShort, StyleAPA, StyleChicago, StyleTurabian}
+
+// ParseStyle validates a ?style= value. Empty selects StyleKemenag.
+func ParseStyle(raw string) (Style, error) {
+	if raw == "" {
+		return StyleKemenag, nil
+	}
+	for _, style := range Styles {
+		if Style(raw) == style {
+			return style, nil
+		}
+	}
+	return "", domain.ErrInvalidCitationStyle
+}
+
+// Format cites span in style. lang ("id" or "en") selects the surah name
+// spelling and the name of the Quran used by the academic styles.
+func Format(style Style, lang string, span reference.Span) (string, error) {
+	name := reference.SurahName(span.SurahID, lang)
+	if name == "" || span.From < 1 || span.To < span.From || span.To > reference.AyahCount(span.SurahID) {
+		return "", domain.ErrInvalidReference
+	}
+
+	quran := "Qur'an"
+	if lang == "id" {
+		quran = "Al-Qur'an"
+	}
+
+	switch style {
+	case StyleKemenag:
+		return fmt.Sprintf("QS. %s [%d]: %s", name, span.SurahID, ayahs(span, "-")), nil
+	case StyleShort:
+		return fmt.Sprintf("(%s %d:%s)", name, span.SurahID, ayahs(span, "-")), nil
+	case StyleAPA:
+		if lang != "id" {
+			quran = "The Qur'an"
+		}
+		return fmt.Sprintf("(%s, %d:%s)", quran, span.SurahID, ayahs(span, "–")), nil
+	case StyleChicago:
+		return fmt.Sprintf("%s, %s %d:%s.", quran, name, span.SurahID, ayahs(span, "–")), nil
+	case StyleTurabian:
+		return fmt.Sprintf("(%s %d:%s)", quran, span.SurahID, ayahs(span, "–")), nil
+	}
+	return "", domain.ErrInvalidCitationStyle
+}
+
+// ayahs formats the ayah part of a span; academic styles use an en dash
+// for ranges, Indonesian styles a hyphen.
+func ayahs(span reference.Span, dash string) string {
+	if span.From == span.To {
+		return strconv.Itoa(span.From)
+	}
+	return strconv.Itoa(span.From) + dash + strconv.Itoa(span.To)
+}
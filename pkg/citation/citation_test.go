@@ -0,0 +1,72 @@
+package citation
+
+import (
+	"errors"
+	"testing"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/pkg/reference"
+)
+
+func TestFormat(t *testing.T) {
+	single := reference.Span{SurahID: 2, From: 255, To: 255}
+	span := reference.Span{SurahID: 2, From: 255, To: 257}
+	tests := []struct {
+		style Style
+		lang  string
+		span  reference.Span
+		want  string
+	}{
+		{StyleKemenag, "id", single, "QS. Al-Baqarah [2]: 255"},
+		{StyleKemenag, "id", span, "QS. Al-Baqarah [2]: 255-257"},
+		{StyleKemenag, "en", single, "QS. Al-Baqara [2]: 255"},
+		{StyleShort, "id", single, "(Al-Baqarah 2:255)"},
+		{StyleShort, "id", span, "(Al-Baqarah 2:255-257)"},
+		{StyleAPA, "en", single, "(The Qur'an, 2:255)"},
+		{StyleAPA, "id", span, "(Al-Qur'an, 2:255–257)"},
+		{StyleChicago, "en", single, "Qur'an, Al-Baqara 2:255."},
+		{StyleChicago, "en", span, "Qur'an, Al-Baqara 2:255–257."},
+		{StyleTurabian, "en", single, "(Qur'an 2:255)"},
+		{StyleTurabian, "id", span, "(Al-Qur'an 2:255–257)"},
+	}
+	for _, tc := range tests {
+		got, err := Format(tc.style, tc.lang, tc.span)
+		if err != nil {
+			t.Errorf("Format(%s, %s, %s): %v", tc.style, tc.lang, tc.span, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("Format(%s, %s, %s) = %q; want %q", tc.style, tc.lang, tc.span, got, tc.want)
+		}
+	}
+}
+
+func TestFormat_Invalid(t *testing.T) {
+	spans := []reference.Span{
+		{SurahID: 0, From: 1, To: 1},
+		{SurahID: 115, From: 1, To: 1},
+		{SurahID: 1, From: 0, To: 1},
+		{SurahID: 1, From: 5, To: 3},
+		{SurahID: 1, From: 1, To: 8},
+	}
+	for _, span := range spans {
+		if _, err := Format(StyleKemenag, "id", span); !errors.Is(err, domain.ErrInvalidReference) {
+			t.Errorf("Format(%v) error = %v; want ErrInvalidReference", span, err)
+		}
+	}
+	if _, err := Format("mla", "id", reference.Span{SurahID: 1, From: 1, To: 1}); !errors.Is(err, domain.ErrInvalidCitationStyle) {
+		t.Errorf("unknown style error = %v; want ErrInvalidCitationStyle", err)
+	}
+}
+
+func TestParseStyle(t *testing.T) {
+	if style, err := ParseStyle(""); err != nil || style != StyleKemenag {
+		t.Errorf("ParseStyle(\"\") = %q, %v; want kemenag", style, err)
+	}
+	if style, err := ParseStyle("apa"); err != nil || style != StyleAPA {
+		t.Errorf("ParseStyle(apa) = %q, %v", style, err)
+	}
+	if _, err := ParseStyle("APA "); !errors.Is(err, domain.ErrInvalidCitationStyle) {
+		t.Errorf("ParseStyle(\"APA \") error = %v", err)
+	}
+}
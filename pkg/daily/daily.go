@@ -0,0 +1,121 @@
+// Package daily picks ayahs deterministically: the same seed always yields
+// the same ayah, so every client asking for a given day sees the same one
+// without any shared state.
+//
+// Usage:
+//
+//	pool, _ := daily.Theme("sabar")
+//	id := pool.Pick(daily.DaySeed(today, "sabar"))
+package daily
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"time"
+	_ "time/tzdata" // DefaultZone must load on images without zoneinfo
+
+	"quran-api-go/pkg/reference"
+)
+
+// DefaultZone is where a day starts and ends unless the client says
+// otherwise.
+const DefaultZone = "Asia/Jakarta"
+
+// Pool is the set of ayahs a pick is drawn from, as spans in the order the
+// pool was written. Every ayah counts once per span it appears in.
+type Pool []reference.Span
+
+// Mushaf is every ayah of the Quran.
+func Mushaf() Pool {
+	pool := make(Pool, 0, 114)
+	for id := 1; reference.AyahCount(id) > 0; id++ {
+		pool = append(pool, reference.Span{SurahID: id, From: 1, To: reference.AyahCount(id)})
+	}
+	return pool
+}
+
+// Surah is every ayah of one surah, or nil for an unknown surah.
+func Surah(id int) Pool {
+	if reference.AyahCount(id) == 0 {
+		return nil
+	}
+	return Pool{{SurahID: id, From: 1, To: reference.AyahCount(id)}}
+}
+
+// ParsePool reads a curated pool written as references, e.g.
+// "2:255, 36:58, 94:5-6" (see reference.Parse).
+func ParsePool(refs string) (Pool, error) {
+	spans, err := reference.Parse(refs)
+	if err != nil {
+		return nil, err
+	}
+	return Pool(spans), nil
+}
+
+// Len is the number of ayahs in the pool.
+func (p Pool) Len() int {
+	n := 0
+	for _, span := range p {
+		n += span.Len()
+	}
+	return n
+}
+
+// Pick returns the global ID of the ayah that seed selects. The choice
+// depends only on seed and the pool, never on time or process state.
+// Pick panics on an empty pool.
+func (p Pool) Pick(seed string) int {
+	sum := sha256.Sum256([]byte(seed))
+	i := int(binary.BigEndian.Uint64(sum[:8]) % uint64(p.Len()))
+	for _, span := range p {
+		if i < span.Len() {
+			id, _ := reference.GlobalID(span.SurahID, span.From+i)
+			return id
+		}
+		i -= span.Len()
+	}
+	panic("unreachable")
+}
+
+// DaySeed is the seed of the ayah for date's calendar day, separate per
+// theme: "daily/2026-10-19" or "daily/2026-10-19/sabar".
+func DaySeed(date time.Time, theme string) string {
+	seed := "daily/" + date.Format(time.DateOnly)
+	if theme != "" {
+		seed += "/" + theme
+	}
+	return seed
+}
+
+// themes are the curated pools offered as ?theme=.
+var themes = map[string]string{
+	"sabar":  "2:45, 153, 155-157; 3:200; 8:46; 11:115; 16:127; 39:10; 94:5-6; 103:3",
+	"syukur": "2:152, 172; 14:7; 16:18; 27:40; 31:12; 39:66; 55:13",
+	"doa":    "1:6; 2:186, 201, 286; 3:8, 147; 7:23; 14:40-41; 20:25-28; 21:87; 25:74; 40:60",
+	"rahmat": "2:218; 6:54; 7:156; 12:87; 15:56; 21:107; 39:53",
+	"ilmu":   "3:190-191; 20:114; 35:28; 39:9; 58:11; 96:1-5",
+}
+
+// Theme returns the curated pool for name.
+func Theme(name string) (Pool, bool) {
+	refs, ok := themes[name]
+	if !ok {
+		return nil, false
+	}
+	pool, err := ParsePool(refs)
+	if err != nil {
+		panic("daily: bad theme " + name + ": " + err.Error())
+	}
+	return pool, true
+}
+
+// Themes lists the theme names, sorted.
+func Themes() []string {
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
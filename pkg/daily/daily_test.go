@@ -0,0 +1,69 @@
+package daily
+
+import (
+	"testing"
+	"time"
+
+	"quran-api-go/pkg/reference"
+)
+
+func TestPoolPick(t *testing.T) {
+	mushaf := Mushaf()
+	if mushaf.Len() != reference.TotalAyahs {
+		t.Fatalf("Mushaf().Len() = %d, want %d", mushaf.Len(), reference.TotalAyahs)
+	}
+
+	id := mushaf.Pick("daily/2026-10-19")
+	if id < 1 || id > reference.TotalAyahs {
+		t.Fatalf("Pick = %d, outside the mushaf", id)
+	}
+	if again := mushaf.Pick("daily/2026-10-19"); again != id {
+		t.Errorf("Pick is not deterministic: %d then %d", id, again)
+	}
+
+	seen := map[int]bool{}
+	for day := 0; day < 30; day++ {
+		seen[mushaf.Pick(DaySeed(time.Date(2026, 1, 1+day, 0, 0, 0, 0, time.UTC), ""))] = true
+	}
+	if len(seen) < 25 {
+		t.Errorf("30 days picked only %d distinct ayahs", len(seen))
+	}
+
+	fatihah := Surah(1)
+	for _, seed := range []string{"a", "b", "c", "d"} {
+		if id := fatihah.Pick(seed); id < 1 || id > 7 {
+			t.Errorf("Surah(1).Pick(%q) = %d, outside Al-Fatihah", seed, id)
+		}
+	}
+	if Surah(115) != nil {
+		t.Error("Surah(115) should be nil")
+	}
+}
+
+func TestThemes(t *testing.T) {
+	for _, name := range Themes() {
+		pool, ok := Theme(name)
+		if !ok || pool.Len() == 0 {
+			t.Errorf("Theme(%q) = %v, %v", name, pool, ok)
+		}
+	}
+	sabar, _ := Theme("sabar")
+	if sabar[1] != (reference.Span{SurahID: 2, From: 153, To: 153}) {
+		t.Errorf("continuation items must stay in surah 2: %v", sabar)
+	}
+	if _, ok := Theme("unknown"); ok {
+		t.Error("unknown theme accepted")
+	}
+}
+
+func TestDaySeed(t *testing.T) {
+	jakarta, _ := time.LoadLocation(DefaultZone)
+	// 20:00 UTC is already the next day in Jakarta (UTC+7).
+	instant := time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC)
+	if got := DaySeed(instant.In(jakarta), "doa"); got != "daily/2026-10-20/doa" {
+		t.Errorf("DaySeed = %q", got)
+	}
+	if got := DaySeed(instant, ""); got != "daily/2026-10-19" {
+		t.Errorf("DaySeed = %q", got)
+	}
+}
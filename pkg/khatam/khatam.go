@@ -0,0 +1,43 @@
+// Package khatam builds reading plans that complete the Quran over a number
+// of days: how to cut the mushaf into near-equal daily portions, Ramadan
+// presets, and iCalendar export.
+//
+// Usage:
+//
+//	ends := khatam.Split(letterCounts, 30) // portion i is [ends[i-1], ends[i])
+package khatam
+
+import "sort"
+
+// Split cuts weights, in order, into parts contiguous runs of near-equal
+// total weight and returns the index one past the end of each run. Each cut
+// goes where the running total is closest to its share of the whole, so
+// rounding never accumulates towards the last day. Every run holds at least
+// one item. Split panics unless 0 < parts <= len(weights).
+func Split(weights []int, parts int) []int {
+	n := len(weights)
+	if parts < 1 || parts > n {
+		panic("khatam: parts out of range")
+	}
+
+	prefix := make([]int, n+1)
+	for i, w := range weights {
+		prefix[i+1] = prefix[i] + w
+	}
+	total := float64(prefix[n])
+
+	ends := make([]int, parts)
+	prev := 0
+	for part := 1; part < parts; part++ {
+		target := total * float64(part) / float64(parts)
+		lo, hi := prev+1, n-(parts-part) // leave an item for every later run
+		end := lo + sort.Search(hi-lo+1, func(i int) bool { return float64(prefix[lo+i]) >= target })
+		if end > hi || (end > lo && target-float64(prefix[end-1]) <= float64(prefix[end])-target) {
+			end--
+		}
+		ends[part-1] = end
+		prev = end
+	}
+	ends[parts-1] = n
+	return ends
+}
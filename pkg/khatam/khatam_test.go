@@ -0,0 +1,122 @@
+package khatam
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []int
+		parts   int
+		want    []int
+	}{
+		{"equal", []int{1, 1, 1, 1, 1, 1}, 3, []int{2, 4, 6}},
+		{"weighted", []int{10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, 2, []int{1, 11}},
+		{"heavy tail keeps every part non-empty", []int{1, 1, 1, 100}, 3, []int{2, 3, 4}},
+		{"one part", []int{3, 4, 5}, 1, []int{3}},
+		{"one item each", []int{3, 4, 5}, 3, []int{1, 2, 3}},
+		{"zero weights", []int{0, 0, 0, 0}, 2, []int{1, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Split(tt.weights, tt.parts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplit_Balance(t *testing.T) {
+	// 6236 uneven weights into 30 parts: no part may stray from its share
+	// by more than the heaviest single item.
+	weights := make([]int, 6236)
+	heaviest, total := 0, 0
+	for i := range weights {
+		weights[i] = 20 + (i*7919)%400
+		heaviest = max(heaviest, weights[i])
+		total += weights[i]
+	}
+	prev := 0
+	for i, end := range Split(weights, 30) {
+		sum := 0
+		for _, w := range weights[prev:end] {
+			sum += w
+		}
+		if diff := sum - total/30; diff > heaviest || diff < -heaviest {
+			t.Errorf("part %d weighs %d, share is %d", i+1, sum, total/30)
+		}
+		prev = end
+	}
+}
+
+func TestRamadanStart(t *testing.T) {
+	tests := []struct {
+		day  string
+		want string
+		ok   bool
+	}{
+		{"2026-10-19", "2027-02-08", true},
+		{"2027-02-08", "2027-02-08", true},
+		{"2027-03-09", "2027-02-08", true}, // 30th of Ramadan
+		{"2027-03-10", "2028-01-28", true},
+		{"2031-01-01", "", false},
+	}
+	for _, tt := range tests {
+		day, _ := time.Parse(time.DateOnly, tt.day)
+		got, ok := RamadanStart(day)
+		if ok != tt.ok || (ok && got.Format(time.DateOnly) != tt.want) {
+			t.Errorf("RamadanStart(%s) = %s, %v; want %s, %v", tt.day, got.Format(time.DateOnly), ok, tt.want, tt.ok)
+		}
+	}
+}
+
+// TestRamadanStartsAhead guards ramadanStarts running out: the presets need
+// the next Ramadan to be known for at least another year.
+func TestRamadanStartsAhead(t *testing.T) {
+	nextYear := time.Now().AddDate(1, 0, 0)
+	if _, ok := RamadanStart(nextYear); !ok {
+		t.Errorf("ramadanStarts ends before %s; add the coming years", nextYear.Format(time.DateOnly))
+	}
+}
+
+func TestPresets(t *testing.T) {
+	for _, name := range Presets() {
+		p, ok := LookupPreset(name)
+		if !ok || p.Days < 1 || p.Offset+p.Days > 30 {
+			t.Errorf("preset %s = %+v", name, p)
+		}
+	}
+}
+
+func TestICS(t *testing.T) {
+	day, _ := time.Parse(time.DateOnly, "2027-02-08")
+	out := string(ICS("Khatam, Ramadan", day, []Event{{
+		UID:         "khatam-1@example",
+		Date:        day,
+		Summary:     "Day 1: Al-Fatihah 1 – Al-Baqarah 141",
+		Description: strings.Repeat("ٱلۡحَمۡدُ لِلَّهِ ", 6),
+	}}))
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"X-WR-CALNAME:Khatam\\, Ramadan\r\n",
+		"DTSTAMP:20270208T000000Z\r\n",
+		"DTSTART;VALUE=DATE:20270208\r\nDTEND;VALUE=DATE:20270209\r\n",
+		"SUMMARY:Day 1: Al-Fatihah 1 – Al-Baqarah 141\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("ICS missing %q:\n%s", want, out)
+		}
+	}
+	for _, line := range strings.Split(out, "\r\n") {
+		if len(line) > 75 || !utf8.ValidString(line) {
+			t.Errorf("badly folded line %q", line)
+		}
+	}
+}
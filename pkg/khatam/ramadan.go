@@ -0,0 +1,71 @@
+package khatam
+
+import (
+	"sort"
+	"time"
+)
+
+// Preset is a ready-made plan for a common occasion. Its values are
+// defaults; anything the client passes explicitly wins.
+type Preset struct {
+	Days int
+	Unit string // juz, page or ayah
+	// Offset is the day of Ramadan the plan starts on, counted from 0.
+	Offset int
+}
+
+var presets = map[string]Preset{
+	// A juz a night, the classic Ramadan khatam.
+	"ramadan": {Days: 30, Unit: "juz"},
+	// Done by the 29th, for months that end a day early: still whole juz,
+	// with one night reading two.
+	"ramadan-29": {Days: 29, Unit: "juz"},
+	// The whole Quran across the last ten nights.
+	"ramadan-last10": {Days: 10, Unit: "juz", Offset: 20},
+}
+
+// LookupPreset returns the preset called name.
+func LookupPreset(name string) (Preset, bool) {
+	p, ok := presets[name]
+	return p, ok
+}
+
+// Presets lists the preset names, sorted.
+func Presets() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ramadanStarts holds the first day of Ramadan 1446-1451 AH by the Umm
+// al-Qura calendar. Sighting-based calendars (Kemenag's isbat, for one) can
+// start a day later; clients following them pass start explicitly.
+//
+// The table runs out after Ramadan 2030, when the presets start requiring
+// start. Extend it each year; TestRamadanStartsAhead fails once less than a
+// year of it is left.
+var ramadanStarts = []string{
+	"2025-03-01",
+	"2026-02-18",
+	"2027-02-08",
+	"2028-01-28",
+	"2029-01-16",
+	"2030-01-05",
+}
+
+// RamadanStart returns the first day of the Ramadan in progress on day, or
+// of the next one. It reports false once day is past the known calendar.
+func RamadanStart(day time.Time) (time.Time, bool) {
+	y, m, d := day.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, day.Location())
+	for _, raw := range ramadanStarts {
+		start, _ := time.ParseInLocation(time.DateOnly, raw, day.Location())
+		if !today.After(start.AddDate(0, 0, 29)) {
+			return start, true
+		}
+	}
+	return time.Time{}, false
+}
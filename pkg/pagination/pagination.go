@@ -2,7 +2,12 @@
 // Use Parse to convert raw query-string values into safe, clamped Params.
 package pagination
 
-import "strconv"
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
 
 // Params holds the parsed and clamped pagination values.
 type Params struct {
@@ -24,7 +29,12 @@ type Params struct {
 func Parse(pageStr, limitStr string) Params {
 	page, _ := strconv.Atoi(pageStr)
 	limit, _ := strconv.Atoi(limitStr)
+	return New(page, limit)
+}
 
+// New is Parse for values that are already integers, e.g. bound and
+// validated by pkg/validator. The same defaults and clamping apply.
+func New(page, limit int) Params {
 	if page < 1 {
 		page = 1
 	}
@@ -41,3 +51,60 @@ func Parse(pageStr, limitStr string) Params {
 		Offset: (page - 1) * limit,
 	}
 }
+
+// Meta is the standard pagination block returned next to list data.
+// Next and Prev are page numbers, or null on the last/first page.
+type Meta struct {
+	Page       int  `json:"page"`
+	Limit      int  `json:"limit"`
+	Total      int  `json:"total"`
+	TotalPages int  `json:"total_pages"`
+	Next       *int `json:"next"`
+	Prev       *int `json:"prev"`
+}
+
+// NewMeta builds the Meta for page p of a list with total items.
+func NewMeta(p Params, total int) Meta {
+	m := Meta{Page: p.Page, Limit: p.Limit, Total: total}
+	if p.Limit > 0 {
+		m.TotalPages = (total + p.Limit - 1) / p.Limit
+	}
+	if p.Page < m.TotalPages {
+		next := p.Page + 1
+		m.Next = &next
+	}
+	if p.Page > 1 {
+		prev := min(p.Page-1, max(m.TotalPages, 1))
+		m.Prev = &prev
+	}
+	return m
+}
+
+// Link returns an RFC 8288 Link header value with first, prev, next and last
+// relations. Each target is u with its page query parameter replaced, so
+// filters and limit carry over. Returns "" for an empty list.
+//
+// Usage:
+//
+//	c.Header("Link", meta.Link(c.Request.URL))
+func (m Meta) Link(u *url.URL) string {
+	if m.TotalPages == 0 {
+		return ""
+	}
+
+	pageURL := func(page int) string {
+		q := u.Query()
+		q.Set("page", strconv.Itoa(page))
+		return (&url.URL{Path: u.Path, RawQuery: q.Encode()}).String()
+	}
+
+	links := []string{fmt.Sprintf(`<%s>; rel="first"`, pageURL(1))}
+	if m.Prev != nil {
+		links = append(links, fmt.Sprintf(`<%s>; rel="prev"`, pageURL(*m.Prev)))
+	}
+	if m.Next != nil {
+		links = append(links, fmt.Sprintf(`<%s>; rel="next"`, pageURL(*m.Next)))
+	}
+	links = append(links, fmt.Sprintf(`<%s>; rel="last"`, pageURL(m.TotalPages)))
+	return strings.Join(links, ", ")
+}
@@ -1,6 +1,9 @@
 package pagination
 
-import "testing"
+import (
+	"net/url"
+	"testing"
+)
 
 func TestParse(t *testing.T) {
 	tests := []struct {
@@ -109,3 +112,60 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMeta(t *testing.T) {
+	tests := []struct {
+		name           string
+		page, limit    int
+		total          int
+		wantTotalPages int
+		wantNext       int // 0 = nil
+		wantPrev       int // 0 = nil
+	}{
+		{"first page", 1, 20, 45, 3, 2, 0},
+		{"middle page", 2, 20, 45, 3, 3, 1},
+		{"last page", 3, 20, 45, 3, 0, 2},
+		{"exact multiple", 2, 10, 20, 2, 0, 1},
+		{"empty list", 1, 20, 0, 0, 0, 0},
+		{"past the end", 9, 20, 45, 3, 0, 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewMeta(Params{Page: tc.page, Limit: tc.limit}, tc.total)
+
+			if got.TotalPages != tc.wantTotalPages {
+				t.Errorf("TotalPages: got %d, want %d", got.TotalPages, tc.wantTotalPages)
+			}
+			if deref(got.Next) != tc.wantNext {
+				t.Errorf("Next: got %d, want %d", deref(got.Next), tc.wantNext)
+			}
+			if deref(got.Prev) != tc.wantPrev {
+				t.Errorf("Prev: got %d, want %d", deref(got.Prev), tc.wantPrev)
+			}
+		})
+	}
+}
+
+func TestMetaLink(t *testing.T) {
+	u, _ := url.Parse("/juz/30/ayah?limit=50&page=1&lang=en")
+	meta := NewMeta(Params{Page: 1, Limit: 50}, 564)
+
+	want := `</juz/30/ayah?lang=en&limit=50&page=1>; rel="first", ` +
+		`</juz/30/ayah?lang=en&limit=50&page=2>; rel="next", ` +
+		`</juz/30/ayah?lang=en&limit=50&page=12>; rel="last"`
+	if got := meta.Link(u); got != want {
+		t.Errorf("Link:\n got %s\nwant %s", got, want)
+	}
+
+	if got := NewMeta(Params{Page: 1, Limit: 50}, 0).Link(u); got != "" {
+		t.Errorf("expected no Link for an empty list, got %s", got)
+	}
+}
+
+func deref(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
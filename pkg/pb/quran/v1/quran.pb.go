@@ -0,0 +1,1321 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: quran/v1/quran.proto
+
+package quranv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Surah struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Number              int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	NameArabic          string                 `protobuf:"bytes,3,opt,name=name_arabic,json=nameArabic,proto3" json:"name_arabic,omitempty"`
+	NameLatin           string                 `protobuf:"bytes,4,opt,name=name_latin,json=nameLatin,proto3" json:"name_latin,omitempty"`
+	NameTransliteration string                 `protobuf:"bytes,5,opt,name=name_transliteration,json=nameTransliteration,proto3" json:"name_transliteration,omitempty"`
+	NumberOfAyahs       int32                  `protobuf:"varint,6,opt,name=number_of_ayahs,json=numberOfAyahs,proto3" json:"number_of_ayahs,omitempty"`
+	// meccan or medinan.
+	RevelationType string `protobuf:"bytes,7,opt,name=revelation_type,json=revelationType,proto3" json:"revelation_type,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Surah) Reset() {
+	*x = Surah{}
+	mi := &file_quran_v1_quran_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Surah) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Surah) ProtoMessage() {}
+
+func (x *Surah) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Surah.ProtoReflect.Descriptor instead.
+func (*Surah) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Surah) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Surah) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Surah) GetNameArabic() string {
+	if x != nil {
+		return x.NameArabic
+	}
+	return ""
+}
+
+func (x *Surah) GetNameLatin() string {
+	if x != nil {
+		return x.NameLatin
+	}
+	return ""
+}
+
+func (x *Surah) GetNameTransliteration() string {
+	if x != nil {
+		return x.NameTransliteration
+	}
+	return ""
+}
+
+func (x *Surah) GetNumberOfAyahs() int32 {
+	if x != nil {
+		return x.NumberOfAyahs
+	}
+	return 0
+}
+
+func (x *Surah) GetRevelationType() string {
+	if x != nil {
+		return x.RevelationType
+	}
+	return ""
+}
+
+type Ayah struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Global ayah ID (1-6236).
+	Id            int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SurahId       int32 `protobuf:"varint,2,opt,name=surah_id,json=surahId,proto3" json:"surah_id,omitempty"`
+	NumberInSurah int32 `protobuf:"varint,3,opt,name=number_in_surah,json=numberInSurah,proto3" json:"number_in_surah,omitempty"`
+	// surah:ayah key, e.g. 2:255.
+	Key           string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	TextUthmani   string `protobuf:"bytes,5,opt,name=text_uthmani,json=textUthmani,proto3" json:"text_uthmani,omitempty"`
+	TranslationId string `protobuf:"bytes,6,opt,name=translation_id,json=translationId,proto3" json:"translation_id,omitempty"`
+	TranslationEn string `protobuf:"bytes,7,opt,name=translation_en,json=translationEn,proto3" json:"translation_en,omitempty"`
+	JuzNumber     int32  `protobuf:"varint,8,opt,name=juz_number,json=juzNumber,proto3" json:"juz_number,omitempty"`
+	// recommended or obligatory; unset when the ayah has no prostration.
+	SajdaType     *string `protobuf:"bytes,9,opt,name=sajda_type,json=sajdaType,proto3,oneof" json:"sajda_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ayah) Reset() {
+	*x = Ayah{}
+	mi := &file_quran_v1_quran_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ayah) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ayah) ProtoMessage() {}
+
+func (x *Ayah) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ayah.ProtoReflect.Descriptor instead.
+func (*Ayah) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Ayah) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Ayah) GetSurahId() int32 {
+	if x != nil {
+		return x.SurahId
+	}
+	return 0
+}
+
+func (x *Ayah) GetNumberInSurah() int32 {
+	if x != nil {
+		return x.NumberInSurah
+	}
+	return 0
+}
+
+func (x *Ayah) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Ayah) GetTextUthmani() string {
+	if x != nil {
+		return x.TextUthmani
+	}
+	return ""
+}
+
+func (x *Ayah) GetTranslationId() string {
+	if x != nil {
+		return x.TranslationId
+	}
+	return ""
+}
+
+func (x *Ayah) GetTranslationEn() string {
+	if x != nil {
+		return x.TranslationEn
+	}
+	return ""
+}
+
+func (x *Ayah) GetJuzNumber() int32 {
+	if x != nil {
+		return x.JuzNumber
+	}
+	return 0
+}
+
+func (x *Ayah) GetSajdaType() string {
+	if x != nil && x.SajdaType != nil {
+		return *x.SajdaType
+	}
+	return ""
+}
+
+type Juz struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	FirstAyahId   int32                  `protobuf:"varint,2,opt,name=first_ayah_id,json=firstAyahId,proto3" json:"first_ayah_id,omitempty"`
+	LastAyahId    int32                  `protobuf:"varint,3,opt,name=last_ayah_id,json=lastAyahId,proto3" json:"last_ayah_id,omitempty"`
+	TotalAyahs    int32                  `protobuf:"varint,4,opt,name=total_ayahs,json=totalAyahs,proto3" json:"total_ayahs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Juz) Reset() {
+	*x = Juz{}
+	mi := &file_quran_v1_quran_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Juz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Juz) ProtoMessage() {}
+
+func (x *Juz) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Juz.ProtoReflect.Descriptor instead.
+func (*Juz) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Juz) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Juz) GetFirstAyahId() int32 {
+	if x != nil {
+		return x.FirstAyahId
+	}
+	return 0
+}
+
+func (x *Juz) GetLastAyahId() int32 {
+	if x != nil {
+		return x.LastAyahId
+	}
+	return 0
+}
+
+func (x *Juz) GetTotalAyahs() int32 {
+	if x != nil {
+		return x.TotalAyahs
+	}
+	return 0
+}
+
+type SearchResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SurahId        int32                  `protobuf:"varint,2,opt,name=surah_id,json=surahId,proto3" json:"surah_id,omitempty"`
+	SurahNameLatin string                 `protobuf:"bytes,3,opt,name=surah_name_latin,json=surahNameLatin,proto3" json:"surah_name_latin,omitempty"`
+	NumberInSurah  int32                  `protobuf:"varint,4,opt,name=number_in_surah,json=numberInSurah,proto3" json:"number_in_surah,omitempty"`
+	Key            string                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	TextUthmani    string                 `protobuf:"bytes,6,opt,name=text_uthmani,json=textUthmani,proto3" json:"text_uthmani,omitempty"`
+	// Translation in the requested language.
+	Translation   string `protobuf:"bytes,7,opt,name=translation,proto3" json:"translation,omitempty"`
+	JuzNumber     int32  `protobuf:"varint,8,opt,name=juz_number,json=juzNumber,proto3" json:"juz_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_quran_v1_quran_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchResult) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchResult) GetSurahId() int32 {
+	if x != nil {
+		return x.SurahId
+	}
+	return 0
+}
+
+func (x *SearchResult) GetSurahNameLatin() string {
+	if x != nil {
+		return x.SurahNameLatin
+	}
+	return ""
+}
+
+func (x *SearchResult) GetNumberInSurah() int32 {
+	if x != nil {
+		return x.NumberInSurah
+	}
+	return 0
+}
+
+func (x *SearchResult) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTextUthmani() string {
+	if x != nil {
+		return x.TextUthmani
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+func (x *SearchResult) GetJuzNumber() int32 {
+	if x != nil {
+		return x.JuzNumber
+	}
+	return 0
+}
+
+type ListSurahsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// meccan or medinan; empty for all surahs.
+	RevelationType string `protobuf:"bytes,1,opt,name=revelation_type,json=revelationType,proto3" json:"revelation_type,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSurahsRequest) Reset() {
+	*x = ListSurahsRequest{}
+	mi := &file_quran_v1_quran_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSurahsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSurahsRequest) ProtoMessage() {}
+
+func (x *ListSurahsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSurahsRequest.ProtoReflect.Descriptor instead.
+func (*ListSurahsRequest) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSurahsRequest) GetRevelationType() string {
+	if x != nil {
+		return x.RevelationType
+	}
+	return ""
+}
+
+type ListSurahsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Surahs        []*Surah               `protobuf:"bytes,1,rep,name=surahs,proto3" json:"surahs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSurahsResponse) Reset() {
+	*x = ListSurahsResponse{}
+	mi := &file_quran_v1_quran_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSurahsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSurahsResponse) ProtoMessage() {}
+
+func (x *ListSurahsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSurahsResponse.ProtoReflect.Descriptor instead.
+func (*ListSurahsResponse) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSurahsResponse) GetSurahs() []*Surah {
+	if x != nil {
+		return x.Surahs
+	}
+	return nil
+}
+
+type GetSurahRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSurahRequest) Reset() {
+	*x = GetSurahRequest{}
+	mi := &file_quran_v1_quran_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSurahRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSurahRequest) ProtoMessage() {}
+
+func (x *GetSurahRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSurahRequest.ProtoReflect.Descriptor instead.
+func (*GetSurahRequest) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSurahRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAyahRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Ayah:
+	//
+	//	*GetAyahRequest_Id
+	//	*GetAyahRequest_Key
+	Ayah          isGetAyahRequest_Ayah `protobuf_oneof:"ayah"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAyahRequest) Reset() {
+	*x = GetAyahRequest{}
+	mi := &file_quran_v1_quran_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAyahRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAyahRequest) ProtoMessage() {}
+
+func (x *GetAyahRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAyahRequest.ProtoReflect.Descriptor instead.
+func (*GetAyahRequest) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAyahRequest) GetAyah() isGetAyahRequest_Ayah {
+	if x != nil {
+		return x.Ayah
+	}
+	return nil
+}
+
+func (x *GetAyahRequest) GetId() int32 {
+	if x != nil {
+		if x, ok := x.Ayah.(*GetAyahRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return 0
+}
+
+func (x *GetAyahRequest) GetKey() string {
+	if x != nil {
+		if x, ok := x.Ayah.(*GetAyahRequest_Key); ok {
+			return x.Key
+		}
+	}
+	return ""
+}
+
+type isGetAyahRequest_Ayah interface {
+	isGetAyahRequest_Ayah()
+}
+
+type GetAyahRequest_Id struct {
+	// Global ayah ID (1-6236).
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3,oneof"`
+}
+
+type GetAyahRequest_Key struct {
+	// surah:ayah key, e.g. 2:255.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3,oneof"`
+}
+
+func (*GetAyahRequest_Id) isGetAyahRequest_Ayah() {}
+
+func (*GetAyahRequest_Key) isGetAyahRequest_Ayah() {}
+
+type BatchGetAyahsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetAyahsRequest) Reset() {
+	*x = BatchGetAyahsRequest{}
+	mi := &file_quran_v1_quran_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetAyahsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAyahsRequest) ProtoMessage() {}
+
+func (x *BatchGetAyahsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAyahsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetAyahsRequest) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchGetAyahsRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetAyahsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ayahs         []*Ayah                `protobuf:"bytes,1,rep,name=ayahs,proto3" json:"ayahs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetAyahsResponse) Reset() {
+	*x = BatchGetAyahsResponse{}
+	mi := &file_quran_v1_quran_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetAyahsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAyahsResponse) ProtoMessage() {}
+
+func (x *BatchGetAyahsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAyahsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetAyahsResponse) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchGetAyahsResponse) GetAyahs() []*Ayah {
+	if x != nil {
+		return x.Ayahs
+	}
+	return nil
+}
+
+type StreamSurahAyahsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SurahId int32                  `protobuf:"varint,1,opt,name=surah_id,json=surahId,proto3" json:"surah_id,omitempty"`
+	// First and last ayah numbers; 0 means the start or end of the surah.
+	From          int32 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            int32 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSurahAyahsRequest) Reset() {
+	*x = StreamSurahAyahsRequest{}
+	mi := &file_quran_v1_quran_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSurahAyahsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSurahAyahsRequest) ProtoMessage() {}
+
+func (x *StreamSurahAyahsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSurahAyahsRequest.ProtoReflect.Descriptor instead.
+func (*StreamSurahAyahsRequest) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamSurahAyahsRequest) GetSurahId() int32 {
+	if x != nil {
+		return x.SurahId
+	}
+	return 0
+}
+
+func (x *StreamSurahAyahsRequest) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *StreamSurahAyahsRequest) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type RandomAyahRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 picks from the whole Quran.
+	SurahId       int32 `protobuf:"varint,1,opt,name=surah_id,json=surahId,proto3" json:"surah_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RandomAyahRequest) Reset() {
+	*x = RandomAyahRequest{}
+	mi := &file_quran_v1_quran_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomAyahRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomAyahRequest) ProtoMessage() {}
+
+func (x *RandomAyahRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomAyahRequest.ProtoReflect.Descriptor instead.
+func (*RandomAyahRequest) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RandomAyahRequest) GetSurahId() int32 {
+	if x != nil {
+		return x.SurahId
+	}
+	return 0
+}
+
+type ListJuzsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJuzsRequest) Reset() {
+	*x = ListJuzsRequest{}
+	mi := &file_quran_v1_quran_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJuzsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJuzsRequest) ProtoMessage() {}
+
+func (x *ListJuzsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJuzsRequest.ProtoReflect.Descriptor instead.
+func (*ListJuzsRequest) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{12}
+}
+
+type ListJuzsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Juzs          []*Juz                 `protobuf:"bytes,1,rep,name=juzs,proto3" json:"juzs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJuzsResponse) Reset() {
+	*x = ListJuzsResponse{}
+	mi := &file_quran_v1_quran_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJuzsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJuzsResponse) ProtoMessage() {}
+
+func (x *ListJuzsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJuzsResponse.ProtoReflect.Descriptor instead.
+func (*ListJuzsResponse) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListJuzsResponse) GetJuzs() []*Juz {
+	if x != nil {
+		return x.Juzs
+	}
+	return nil
+}
+
+type GetJuzRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJuzRequest) Reset() {
+	*x = GetJuzRequest{}
+	mi := &file_quran_v1_quran_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJuzRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJuzRequest) ProtoMessage() {}
+
+func (x *GetJuzRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJuzRequest.ProtoReflect.Descriptor instead.
+func (*GetJuzRequest) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetJuzRequest) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+type StreamJuzAyahsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamJuzAyahsRequest) Reset() {
+	*x = StreamJuzAyahsRequest{}
+	mi := &file_quran_v1_quran_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamJuzAyahsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamJuzAyahsRequest) ProtoMessage() {}
+
+func (x *StreamJuzAyahsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamJuzAyahsRequest.ProtoReflect.Descriptor instead.
+func (*StreamJuzAyahsRequest) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StreamJuzAyahsRequest) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// id (default) or en.
+	Lang string `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	// Optional filters; 0 means no filter.
+	SurahId int32 `protobuf:"varint,3,opt,name=surah_id,json=surahId,proto3" json:"surah_id,omitempty"`
+	Juz     int32 `protobuf:"varint,4,opt,name=juz,proto3" json:"juz,omitempty"`
+	// Defaults: page 1, limit 20 (max 100).
+	Page          int32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_quran_v1_quran_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetSurahId() int32 {
+	if x != nil {
+		return x.SurahId
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetJuz() int32 {
+	if x != nil {
+		return x.Juz
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Results       []*SearchResult        `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_quran_v1_quran_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quran_v1_quran_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_quran_v1_quran_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_quran_v1_quran_proto protoreflect.FileDescriptor
+
+const file_quran_v1_quran_proto_rawDesc = "" +
+	"\n" +
+	"\x14quran/v1/quran.proto\x12\bquran.v1\"\xf3\x01\n" +
+	"\x05Surah\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x1f\n" +
+	"\vname_arabic\x18\x03 \x01(\tR\n" +
+	"nameArabic\x12\x1d\n" +
+	"\n" +
+	"name_latin\x18\x04 \x01(\tR\tnameLatin\x121\n" +
+	"\x14name_transliteration\x18\x05 \x01(\tR\x13nameTransliteration\x12&\n" +
+	"\x0fnumber_of_ayahs\x18\x06 \x01(\x05R\rnumberOfAyahs\x12'\n" +
+	"\x0frevelation_type\x18\a \x01(\tR\x0erevelationType\"\xae\x02\n" +
+	"\x04Ayah\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bsurah_id\x18\x02 \x01(\x05R\asurahId\x12&\n" +
+	"\x0fnumber_in_surah\x18\x03 \x01(\x05R\rnumberInSurah\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x12!\n" +
+	"\ftext_uthmani\x18\x05 \x01(\tR\vtextUthmani\x12%\n" +
+	"\x0etranslation_id\x18\x06 \x01(\tR\rtranslationId\x12%\n" +
+	"\x0etranslation_en\x18\a \x01(\tR\rtranslationEn\x12\x1d\n" +
+	"\n" +
+	"juz_number\x18\b \x01(\x05R\tjuzNumber\x12\"\n" +
+	"\n" +
+	"sajda_type\x18\t \x01(\tH\x00R\tsajdaType\x88\x01\x01B\r\n" +
+	"\v_sajda_type\"\x84\x01\n" +
+	"\x03Juz\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\"\n" +
+	"\rfirst_ayah_id\x18\x02 \x01(\x05R\vfirstAyahId\x12 \n" +
+	"\flast_ayah_id\x18\x03 \x01(\x05R\n" +
+	"lastAyahId\x12\x1f\n" +
+	"\vtotal_ayahs\x18\x04 \x01(\x05R\n" +
+	"totalAyahs\"\x81\x02\n" +
+	"\fSearchResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bsurah_id\x18\x02 \x01(\x05R\asurahId\x12(\n" +
+	"\x10surah_name_latin\x18\x03 \x01(\tR\x0esurahNameLatin\x12&\n" +
+	"\x0fnumber_in_surah\x18\x04 \x01(\x05R\rnumberInSurah\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\x12!\n" +
+	"\ftext_uthmani\x18\x06 \x01(\tR\vtextUthmani\x12 \n" +
+	"\vtranslation\x18\a \x01(\tR\vtranslation\x12\x1d\n" +
+	"\n" +
+	"juz_number\x18\b \x01(\x05R\tjuzNumber\"<\n" +
+	"\x11ListSurahsRequest\x12'\n" +
+	"\x0frevelation_type\x18\x01 \x01(\tR\x0erevelationType\"=\n" +
+	"\x12ListSurahsResponse\x12'\n" +
+	"\x06surahs\x18\x01 \x03(\v2\x0f.quran.v1.SurahR\x06surahs\"!\n" +
+	"\x0fGetSurahRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\">\n" +
+	"\x0eGetAyahRequest\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\x05H\x00R\x02id\x12\x12\n" +
+	"\x03key\x18\x02 \x01(\tH\x00R\x03keyB\x06\n" +
+	"\x04ayah\"(\n" +
+	"\x14BatchGetAyahsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids\"=\n" +
+	"\x15BatchGetAyahsResponse\x12$\n" +
+	"\x05ayahs\x18\x01 \x03(\v2\x0e.quran.v1.AyahR\x05ayahs\"X\n" +
+	"\x17StreamSurahAyahsRequest\x12\x19\n" +
+	"\bsurah_id\x18\x01 \x01(\x05R\asurahId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x05R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x05R\x02to\".\n" +
+	"\x11RandomAyahRequest\x12\x19\n" +
+	"\bsurah_id\x18\x01 \x01(\x05R\asurahId\"\x11\n" +
+	"\x0fListJuzsRequest\"5\n" +
+	"\x10ListJuzsResponse\x12!\n" +
+	"\x04juzs\x18\x01 \x03(\v2\r.quran.v1.JuzR\x04juzs\"'\n" +
+	"\rGetJuzRequest\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\"/\n" +
+	"\x15StreamJuzAyahsRequest\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\"\x90\x01\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\x12\x19\n" +
+	"\bsurah_id\x18\x03 \x01(\x05R\asurahId\x12\x10\n" +
+	"\x03juz\x18\x04 \x01(\x05R\x03juz\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\x82\x01\n" +
+	"\x0eSearchResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x120\n" +
+	"\aresults\x18\x04 \x03(\v2\x16.quran.v1.SearchResultR\aresults2\x91\x05\n" +
+	"\fQuranService\x12G\n" +
+	"\n" +
+	"ListSurahs\x12\x1b.quran.v1.ListSurahsRequest\x1a\x1c.quran.v1.ListSurahsResponse\x126\n" +
+	"\bGetSurah\x12\x19.quran.v1.GetSurahRequest\x1a\x0f.quran.v1.Surah\x123\n" +
+	"\aGetAyah\x12\x18.quran.v1.GetAyahRequest\x1a\x0e.quran.v1.Ayah\x12P\n" +
+	"\rBatchGetAyahs\x12\x1e.quran.v1.BatchGetAyahsRequest\x1a\x1f.quran.v1.BatchGetAyahsResponse\x12G\n" +
+	"\x10StreamSurahAyahs\x12!.quran.v1.StreamSurahAyahsRequest\x1a\x0e.quran.v1.Ayah0\x01\x129\n" +
+	"\n" +
+	"RandomAyah\x12\x1b.quran.v1.RandomAyahRequest\x1a\x0e.quran.v1.Ayah\x12A\n" +
+	"\bListJuzs\x12\x19.quran.v1.ListJuzsRequest\x1a\x1a.quran.v1.ListJuzsResponse\x120\n" +
+	"\x06GetJuz\x12\x17.quran.v1.GetJuzRequest\x1a\r.quran.v1.Juz\x12C\n" +
+	"\x0eStreamJuzAyahs\x12\x1f.quran.v1.StreamJuzAyahsRequest\x1a\x0e.quran.v1.Ayah0\x01\x12;\n" +
+	"\x06Search\x12\x17.quran.v1.SearchRequest\x1a\x18.quran.v1.SearchResponseB&Z$quran-api-go/pkg/pb/quran/v1;quranv1b\x06proto3"
+
+var (
+	file_quran_v1_quran_proto_rawDescOnce sync.Once
+	file_quran_v1_quran_proto_rawDescData []byte
+)
+
+func file_quran_v1_quran_proto_rawDescGZIP() []byte {
+	file_quran_v1_quran_proto_rawDescOnce.Do(func() {
+		file_quran_v1_quran_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_quran_v1_quran_proto_rawDesc), len(file_quran_v1_quran_proto_rawDesc)))
+	})
+	return file_quran_v1_quran_proto_rawDescData
+}
+
+var file_quran_v1_quran_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_quran_v1_quran_proto_goTypes = []any{
+	(*Surah)(nil),                   // 0: quran.v1.Surah
+	(*Ayah)(nil),                    // 1: quran.v1.Ayah
+	(*Juz)(nil),                     // 2: quran.v1.Juz
+	(*SearchResult)(nil),            // 3: quran.v1.SearchResult
+	(*ListSurahsRequest)(nil),       // 4: quran.v1.ListSurahsRequest
+	(*ListSurahsResponse)(nil),      // 5: quran.v1.ListSurahsResponse
+	(*GetSurahRequest)(nil),         // 6: quran.v1.GetSurahRequest
+	(*GetAyahRequest)(nil),          // 7: quran.v1.GetAyahRequest
+	(*BatchGetAyahsRequest)(nil),    // 8: quran.v1.BatchGetAyahsRequest
+	(*BatchGetAyahsResponse)(nil),   // 9: quran.v1.BatchGetAyahsResponse
+	(*StreamSurahAyahsRequest)(nil), // 10: quran.v1.StreamSurahAyahsRequest
+	(*RandomAyahRequest)(nil),       // 11: quran.v1.RandomAyahRequest
+	(*ListJuzsRequest)(nil),         // 12: quran.v1.ListJuzsRequest
+	(*ListJuzsResponse)(nil),        // 13: quran.v1.ListJuzsResponse
+	(*GetJuzRequest)(nil),           // 14: quran.v1.GetJuzRequest
+	(*StreamJuzAyahsRequest)(nil),   // 15: quran.v1.StreamJuzAyahsRequest
+	(*SearchRequest)(nil),           // 16: quran.v1.SearchRequest
+	(*SearchResponse)(nil),          // 17: quran.v1.SearchResponse
+}
+var file_quran_v1_quran_proto_depIdxs = []int32{
+	0,  // 0: quran.v1.ListSurahsResponse.surahs:type_name -> quran.v1.Surah
+	1,  // 1: quran.v1.BatchGetAyahsResponse.ayahs:type_name -> quran.v1.Ayah
+	2,  // 2: quran.v1.ListJuzsResponse.juzs:type_name -> quran.v1.Juz
+	3,  // 3: quran.v1.SearchResponse.results:type_name -> quran.v1.SearchResult
+	4,  // 4: quran.v1.QuranService.ListSurahs:input_type -> quran.v1.ListSurahsRequest
+	6,  // 5: quran.v1.QuranService.GetSurah:input_type -> quran.v1.GetSurahRequest
+	7,  // 6: quran.v1.QuranService.GetAyah:input_type -> quran.v1.GetAyahRequest
+	8,  // 7: quran.v1.QuranService.BatchGetAyahs:input_type -> quran.v1.BatchGetAyahsRequest
+	10, // 8: quran.v1.QuranService.StreamSurahAyahs:input_type -> quran.v1.StreamSurahAyahsRequest
+	11, // 9: quran.v1.QuranService.RandomAyah:input_type -> quran.v1.RandomAyahRequest
+	12, // 10: quran.v1.QuranService.ListJuzs:input_type -> quran.v1.ListJuzsRequest
+	14, // 11: quran.v1.QuranService.GetJuz:input_type -> quran.v1.GetJuzRequest
+	15, // 12: quran.v1.QuranService.StreamJuzAyahs:input_type -> quran.v1.StreamJuzAyahsRequest
+	16, // 13: quran.v1.QuranService.Search:input_type -> quran.v1.SearchRequest
+	5,  // 14: quran.v1.QuranService.ListSurahs:output_type -> quran.v1.ListSurahsResponse
+	0,  // 15: quran.v1.QuranService.GetSurah:output_type -> quran.v1.Surah
+	1,  // 16: quran.v1.QuranService.GetAyah:output_type -> quran.v1.Ayah
+	9,  // 17: quran.v1.QuranService.BatchGetAyahs:output_type -> quran.v1.BatchGetAyahsResponse
+	1,  // 18: quran.v1.QuranService.StreamSurahAyahs:output_type -> quran.v1.Ayah
+	1,  // 19: quran.v1.QuranService.RandomAyah:output_type -> quran.v1.Ayah
+	13, // 20: quran.v1.QuranService.ListJuzs:output_type -> quran.v1.ListJuzsResponse
+	2,  // 21: quran.v1.QuranService.GetJuz:output_type -> quran.v1.Juz
+	1,  // 22: quran.v1.QuranService.StreamJuzAyahs:output_type -> quran.v1.Ayah
+	17, // 23: quran.v1.QuranService.Search:output_type -> quran.v1.SearchResponse
+	14, // [14:24] is the sub-list for method output_type
+	4,  // [4:14] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_quran_v1_quran_proto_init() }
+func file_quran_v1_quran_proto_init() {
+	if File_quran_v1_quran_proto != nil {
+		return
+	}
+	file_quran_v1_quran_proto_msgTypes[1].OneofWrappers = []any{}
+	file_quran_v1_quran_proto_msgTypes[7].OneofWrappers = []any{
+		(*GetAyahRequest_Id)(nil),
+		(*GetAyahRequest_Key)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_quran_v1_quran_proto_rawDesc), len(file_quran_v1_quran_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_quran_v1_quran_proto_goTypes,
+		DependencyIndexes: file_quran_v1_quran_proto_depIdxs,
+		MessageInfos:      file_quran_v1_quran_proto_msgTypes,
+	}.Build()
+	File_quran_v1_quran_proto = out.File
+	file_quran_v1_quran_proto_goTypes = nil
+	file_quran_v1_quran_proto_depIdxs = nil
+}
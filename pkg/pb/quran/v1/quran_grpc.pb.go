@@ -0,0 +1,502 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: quran/v1/quran.proto
+
+package quranv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	QuranService_ListSurahs_FullMethodName       = "/quran.v1.QuranService/ListSurahs"
+	QuranService_GetSurah_FullMethodName         = "/quran.v1.QuranService/GetSurah"
+	QuranService_GetAyah_FullMethodName          = "/quran.v1.QuranService/GetAyah"
+	QuranService_BatchGetAyahs_FullMethodName    = "/quran.v1.QuranService/BatchGetAyahs"
+	QuranService_StreamSurahAyahs_FullMethodName = "/quran.v1.QuranService/StreamSurahAyahs"
+	QuranService_RandomAyah_FullMethodName       = "/quran.v1.QuranService/RandomAyah"
+	QuranService_ListJuzs_FullMethodName         = "/quran.v1.QuranService/ListJuzs"
+	QuranService_GetJuz_FullMethodName           = "/quran.v1.QuranService/GetJuz"
+	QuranService_StreamJuzAyahs_FullMethodName   = "/quran.v1.QuranService/StreamJuzAyahs"
+	QuranService_Search_FullMethodName           = "/quran.v1.QuranService/Search"
+)
+
+// QuranServiceClient is the client API for QuranService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// QuranService serves the same data as the REST API to internal services.
+// Errors use standard gRPC codes: INVALID_ARGUMENT for bad input, NOT_FOUND
+// for unknown surahs, ayahs and juz, INTERNAL otherwise.
+type QuranServiceClient interface {
+	// ListSurahs returns all 114 surahs, optionally filtered by revelation type.
+	ListSurahs(ctx context.Context, in *ListSurahsRequest, opts ...grpc.CallOption) (*ListSurahsResponse, error)
+	// GetSurah returns one surah by ID (1-114).
+	GetSurah(ctx context.Context, in *GetSurahRequest, opts ...grpc.CallOption) (*Surah, error)
+	// GetAyah returns one ayah by global ID or surah:ayah key.
+	GetAyah(ctx context.Context, in *GetAyahRequest, opts ...grpc.CallOption) (*Ayah, error)
+	// BatchGetAyahs returns up to 100 ayahs in request order. Unknown IDs are
+	// skipped.
+	BatchGetAyahs(ctx context.Context, in *BatchGetAyahsRequest, opts ...grpc.CallOption) (*BatchGetAyahsResponse, error)
+	// StreamSurahAyahs streams a surah's ayahs in order, optionally limited to
+	// ayah numbers from..to.
+	StreamSurahAyahs(ctx context.Context, in *StreamSurahAyahsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ayah], error)
+	// RandomAyah returns a random ayah, optionally from one surah.
+	RandomAyah(ctx context.Context, in *RandomAyahRequest, opts ...grpc.CallOption) (*Ayah, error)
+	// ListJuzs returns all 30 juz.
+	ListJuzs(ctx context.Context, in *ListJuzsRequest, opts ...grpc.CallOption) (*ListJuzsResponse, error)
+	// GetJuz returns one juz by number (1-30).
+	GetJuz(ctx context.Context, in *GetJuzRequest, opts ...grpc.CallOption) (*Juz, error)
+	// StreamJuzAyahs streams every ayah of a juz in mushaf order.
+	StreamJuzAyahs(ctx context.Context, in *StreamJuzAyahsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ayah], error)
+	// Search runs a full-text search over the Arabic text and translations.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+}
+
+type quranServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuranServiceClient(cc grpc.ClientConnInterface) QuranServiceClient {
+	return &quranServiceClient{cc}
+}
+
+func (c *quranServiceClient) ListSurahs(ctx context.Context, in *ListSurahsRequest, opts ...grpc.CallOption) (*ListSurahsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSurahsResponse)
+	err := c.cc.Invoke(ctx, QuranService_ListSurahs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quranServiceClient) GetSurah(ctx context.Context, in *GetSurahRequest, opts ...grpc.CallOption) (*Surah, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Surah)
+	err := c.cc.Invoke(ctx, QuranService_GetSurah_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quranServiceClient) GetAyah(ctx context.Context, in *GetAyahRequest, opts ...grpc.CallOption) (*Ayah, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ayah)
+	err := c.cc.Invoke(ctx, QuranService_GetAyah_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quranServiceClient) BatchGetAyahs(ctx context.Context, in *BatchGetAyahsRequest, opts ...grpc.CallOption) (*BatchGetAyahsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetAyahsResponse)
+	err := c.cc.Invoke(ctx, QuranService_BatchGetAyahs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quranServiceClient) StreamSurahAyahs(ctx context.Context, in *StreamSurahAyahsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ayah], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuranService_ServiceDesc.Streams[0], QuranService_StreamSurahAyahs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamSurahAyahsRequest, Ayah]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuranService_StreamSurahAyahsClient = grpc.ServerStreamingClient[Ayah]
+
+func (c *quranServiceClient) RandomAyah(ctx context.Context, in *RandomAyahRequest, opts ...grpc.CallOption) (*Ayah, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ayah)
+	err := c.cc.Invoke(ctx, QuranService_RandomAyah_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quranServiceClient) ListJuzs(ctx context.Context, in *ListJuzsRequest, opts ...grpc.CallOption) (*ListJuzsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJuzsResponse)
+	err := c.cc.Invoke(ctx, QuranService_ListJuzs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quranServiceClient) GetJuz(ctx context.Context, in *GetJuzRequest, opts ...grpc.CallOption) (*Juz, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Juz)
+	err := c.cc.Invoke(ctx, QuranService_GetJuz_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quranServiceClient) StreamJuzAyahs(ctx context.Context, in *StreamJuzAyahsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Ayah], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QuranService_ServiceDesc.Streams[1], QuranService_StreamJuzAyahs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamJuzAyahsRequest, Ayah]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuranService_StreamJuzAyahsClient = grpc.ServerStreamingClient[Ayah]
+
+func (c *quranServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, QuranService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuranServiceServer is the server API for QuranService service.
+// All implementations must embed UnimplementedQuranServiceServer
+// for forward compatibility.
+//
+// QuranService serves the same data as the REST API to internal services.
+// Errors use standard gRPC codes: INVALID_ARGUMENT for bad input, NOT_FOUND
+// for unknown surahs, ayahs and juz, INTERNAL otherwise.
+type QuranServiceServer interface {
+	// ListSurahs returns all 114 surahs, optionally filtered by revelation type.
+	ListSurahs(context.Context, *ListSurahsRequest) (*ListSurahsResponse, error)
+	// GetSurah returns one surah by ID (1-114).
+	GetSurah(context.Context, *GetSurahRequest) (*Surah, error)
+	// GetAyah returns one ayah by global ID or surah:ayah key.
+	GetAyah(context.Context, *GetAyahRequest) (*Ayah, error)
+	// BatchGetAyahs returns up to 100 ayahs in request order. Unknown IDs are
+	// skipped.
+	BatchGetAyahs(context.Context, *BatchGetAyahsRequest) (*BatchGetAyahsResponse, error)
+	// StreamSurahAyahs streams a surah's ayahs in order, optionally limited to
+	// ayah numbers from..to.
+	StreamSurahAyahs(*StreamSurahAyahsRequest, grpc.ServerStreamingServer[Ayah]) error
+	// RandomAyah returns a random ayah, optionally from one surah.
+	RandomAyah(context.Context, *RandomAyahRequest) (*Ayah, error)
+	// ListJuzs returns all 30 juz.
+	ListJuzs(context.Context, *ListJuzsRequest) (*ListJuzsResponse, error)
+	// GetJuz returns one juz by number (1-30).
+	GetJuz(context.Context, *GetJuzRequest) (*Juz, error)
+	// StreamJuzAyahs streams every ayah of a juz in mushaf order.
+	StreamJuzAyahs(*StreamJuzAyahsRequest, grpc.ServerStreamingServer[Ayah]) error
+	// Search runs a full-text search over the Arabic text and translations.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	mustEmbedUnimplementedQuranServiceServer()
+}
+
+// UnimplementedQuranServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuranServiceServer struct{}
+
+func (UnimplementedQuranServiceServer) ListSurahs(context.Context, *ListSurahsRequest) (*ListSurahsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSurahs not implemented")
+}
+func (UnimplementedQuranServiceServer) GetSurah(context.Context, *GetSurahRequest) (*Surah, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSurah not implemented")
+}
+func (UnimplementedQuranServiceServer) GetAyah(context.Context, *GetAyahRequest) (*Ayah, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAyah not implemented")
+}
+func (UnimplementedQuranServiceServer) BatchGetAyahs(context.Context, *BatchGetAyahsRequest) (*BatchGetAyahsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetAyahs not implemented")
+}
+func (UnimplementedQuranServiceServer) StreamSurahAyahs(*StreamSurahAyahsRequest, grpc.ServerStreamingServer[Ayah]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSurahAyahs not implemented")
+}
+func (UnimplementedQuranServiceServer) RandomAyah(context.Context, *RandomAyahRequest) (*Ayah, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RandomAyah not implemented")
+}
+func (UnimplementedQuranServiceServer) ListJuzs(context.Context, *ListJuzsRequest) (*ListJuzsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJuzs not implemented")
+}
+func (UnimplementedQuranServiceServer) GetJuz(context.Context, *GetJuzRequest) (*Juz, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJuz not implemented")
+}
+func (UnimplementedQuranServiceServer) StreamJuzAyahs(*StreamJuzAyahsRequest, grpc.ServerStreamingServer[Ayah]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamJuzAyahs not implemented")
+}
+func (UnimplementedQuranServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedQuranServiceServer) mustEmbedUnimplementedQuranServiceServer() {}
+func (UnimplementedQuranServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeQuranServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuranServiceServer will
+// result in compilation errors.
+type UnsafeQuranServiceServer interface {
+	mustEmbedUnimplementedQuranServiceServer()
+}
+
+func RegisterQuranServiceServer(s grpc.ServiceRegistrar, srv QuranServiceServer) {
+	// If the following call pancis, it indicates UnimplementedQuranServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuranService_ServiceDesc, srv)
+}
+
+func _QuranService_ListSurahs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSurahsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuranServiceServer).ListSurahs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuranService_ListSurahs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuranServiceServer).ListSurahs(ctx, req.(*ListSurahsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuranService_GetSurah_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSurahRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuranServiceServer).GetSurah(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuranService_GetSurah_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuranServiceServer).GetSurah(ctx, req.(*GetSurahRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuranService_GetAyah_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAyahRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuranServiceServer).GetAyah(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuranService_GetAyah_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuranServiceServer).GetAyah(ctx, req.(*GetAyahRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuranService_BatchGetAyahs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetAyahsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuranServiceServer).BatchGetAyahs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuranService_BatchGetAyahs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuranServiceServer).BatchGetAyahs(ctx, req.(*BatchGetAyahsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuranService_StreamSurahAyahs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSurahAyahsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuranServiceServer).StreamSurahAyahs(m, &grpc.GenericServerStream[StreamSurahAyahsRequest, Ayah]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuranService_StreamSurahAyahsServer = grpc.ServerStreamingServer[Ayah]
+
+func _QuranService_RandomAyah_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandomAyahRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuranServiceServer).RandomAyah(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuranService_RandomAyah_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuranServiceServer).RandomAyah(ctx, req.(*RandomAyahRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuranService_ListJuzs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJuzsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuranServiceServer).ListJuzs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuranService_ListJuzs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuranServiceServer).ListJuzs(ctx, req.(*ListJuzsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuranService_GetJuz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJuzRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuranServiceServer).GetJuz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuranService_GetJuz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuranServiceServer).GetJuz(ctx, req.(*GetJuzRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuranService_StreamJuzAyahs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamJuzAyahsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuranServiceServer).StreamJuzAyahs(m, &grpc.GenericServerStream[StreamJuzAyahsRequest, Ayah]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QuranService_StreamJuzAyahsServer = grpc.ServerStreamingServer[Ayah]
+
+func _QuranService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuranServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuranService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuranServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuranService_ServiceDesc is the grpc.ServiceDesc for QuranService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuranService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "quran.v1.QuranService",
+	HandlerType: (*QuranServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSurahs",
+			Handler:    _QuranService_ListSurahs_Handler,
+		},
+		{
+			MethodName: "GetSurah",
+			Handler:    _QuranService_GetSurah_Handler,
+		},
+		{
+			MethodName: "GetAyah",
+			Handler:    _QuranService_GetAyah_Handler,
+		},
+		{
+			MethodName: "BatchGetAyahs",
+			Handler:    _QuranService_BatchGetAyahs_Handler,
+		},
+		{
+			MethodName: "RandomAyah",
+			Handler:    _QuranService_RandomAyah_Handler,
+		},
+		{
+			MethodName: "ListJuzs",
+			Handler:    _QuranService_ListJuzs_Handler,
+		},
+		{
+			MethodName: "GetJuz",
+			Handler:    _QuranService_GetJuz_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _QuranService_Search_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSurahAyahs",
+			Handler:       _QuranService_StreamSurahAyahs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamJuzAyahs",
+			Handler:       _QuranService_StreamJuzAyahs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "quran/v1/quran.proto",
+}
@@ -0,0 +1,260 @@
+package reference
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// articles are the Arabic definite-article spellings that prefix many surah
+// names ("Al-Baqarah", "Asy-Syams", "Adh-Dhariyat"). They are optional when
+// matching so "Baqarah" and "Al-Baqarah" resolve to the same surah. The
+// English "the" of the meanings ("The Cow") is treated the same way.
+var articles = map[string]struct{}{
+	"al": {}, "an": {}, "ar": {}, "as": {}, "asy": {}, "ash": {},
+	"at": {}, "az": {}, "ad": {}, "adh": {}, "ath": {}, "the": {},
+}
+
+// arabicArticle is the definite article written joined to an Arabic name
+// ("البقرة").
+const arabicArticle = "ال"
+
+// foldings collapse Indonesian, pesantren and English transliteration
+// differences ("Asy-Syams"/"Ash-Shams", "Al-Baqoroh"/"Al-Baqarah",
+// "Al-Kausar"/"Al-Kawthar") into one spelling, and the hamza, alif and ta
+// marbuta variants of Arabic script ("الإسراء"/"الاسراء").
+// Order matters: digraphs are folded before single letters.
+var foldings = strings.NewReplacer(
+	"sy", "sh",
+	"dh", "z",
+	"dz", "z",
+	"th", "s",
+	"ts", "s",
+	"gh", "g",
+	"ee", "i",
+	"oo", "u",
+	"q", "k",
+	"w", "u",
+	"y", "i",
+	"e", "i",
+	"o", "a",
+	"أ", "ا",
+	"إ", "ا",
+	"آ", "ا",
+	"ٱ", "ا",
+	"ة", "ه",
+	"ى", "ي",
+)
+
+// nameEntry is one normalised spelling of a surah name. English meanings
+// are too alike ("The Iron"/"Imran") for MatchSurah to guess at, so they are
+// flagged to only ever match exactly there.
+type nameEntry struct {
+	key     string
+	name    string
+	number  int
+	meaning bool
+}
+
+// nameEntries lists every normalised spelling, and nameIndex maps each to
+// its surah number.
+var nameEntries, nameIndex = buildNameIndex()
+
+func buildNameIndex() ([]nameEntry, map[string]int) {
+	var entries []nameEntry
+	index := make(map[string]int)
+	for _, s := range surahNames {
+		names := append([]string{s.Latin, s.English, s.Arabic}, s.Aliases...)
+		if s.Meaning != "" {
+			names = append(names, s.Meaning)
+		}
+		for _, name := range names {
+			for _, key := range []string{normaliseName(name, true), normaliseName(name, false)} {
+				if _, ok := index[key]; !ok {
+					entries = append(entries, nameEntry{key, name, s.Number, name == s.Meaning})
+				}
+				index[key] = s.Number
+			}
+		}
+	}
+	return entries, index
+}
+
+// MatchSurah resolves a surah name to its number (1-114). Matching ignores
+// case, punctuation, the definite article and common transliteration
+// variants, and falls back to the closest spelling within a small edit
+// distance.
+func MatchSurah(name string) (int, bool) {
+	key := normaliseName(name, true)
+	if key == "" {
+		return 0, false
+	}
+	if n, ok := nameIndex[key]; ok {
+		return n, true
+	}
+	if n, ok := nameIndex[normaliseName(name, false)]; ok {
+		return n, true
+	}
+
+	// Short keys ("sad", "kaf", "tin") are too close to each other to guess.
+	maxDist := len(key) / 4
+	if maxDist == 0 {
+		return 0, false
+	}
+
+	best, bestDist, ambiguous := 0, maxDist+1, false
+	for _, e := range nameEntries {
+		if e.meaning {
+			continue
+		}
+		d := levenshtein(key, e.key)
+		switch {
+		case d < bestDist:
+			best, bestDist, ambiguous = e.number, d, false
+		case d == bestDist && e.number != best:
+			ambiguous = true
+		}
+	}
+	if best == 0 || ambiguous {
+		return 0, false
+	}
+	return best, true
+}
+
+// SurahMatch is a surah found by SearchSurahs. Name is the spelling that
+// matched best and Score how closely, from 1 for an exact match down.
+type SurahMatch struct {
+	Number int
+	Name   string
+	Score  float64
+}
+
+// SearchSurahs ranks the surahs whose names resemble q, best first, and
+// returns at most limit of them. Like MatchSurah it accepts any spelling
+// (Latin, English, Arabic, a meaning or an alias), but it also matches
+// prefixes and parts of names ("baq", "imran") and never refuses an
+// ambiguous query, so it suits search-as-you-type. Only the spellings in
+// surahNames are searched, not the names a dataset may store (such as the
+// Indonesian meaning "Pembukaan").
+func SearchSurahs(q string, limit int) []SurahMatch {
+	keys := []string{normaliseName(q, true), normaliseName(q, false)}
+	if keys[0] == "" {
+		return nil
+	}
+
+	best := make(map[int]SurahMatch)
+	for _, e := range nameEntries {
+		for _, key := range keys {
+			score := nameScore(key, e.key)
+			if e.meaning && score < 0.8 {
+				// As in MatchSurah, meanings are too alike to match with
+				// typos: "cave" is one letter from "Sovereignty"'s prefix.
+				continue
+			}
+			if score > best[e.number].Score {
+				best[e.number] = SurahMatch{Number: e.number, Name: e.name, Score: score}
+			}
+		}
+	}
+
+	matches := make([]SurahMatch, 0, len(best))
+	for _, m := range best {
+		matches = append(matches, m)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Number < matches[j].Number
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// nameScore rates how well the normalised query key matches the normalised
+// spelling name: 1 when equal, 0.9 for a prefix, 0.8 when found inside it,
+// and less the more edits separate them, up to one edit per four letters.
+// Keys shorter than three letters only match exactly. Zero means no match.
+func nameScore(key, name string) float64 {
+	n := len([]rune(key))
+	switch {
+	case key == name:
+		return 1
+	case n < 3:
+		// One or two letters ("a", "al") start half the names.
+		return 0
+	case strings.HasPrefix(name, key):
+		return 0.9
+	case strings.Contains(name, key):
+		return 0.8
+	case n < 4:
+		// Short keys ("sad", "kaf", "tin") are too close to each other to
+		// guess.
+		return 0
+	}
+	d := levenshtein(key, name)
+	if prefix := []rune(name); len(prefix) > n {
+		// A typo in a name still being typed: "baqr" for "al-baqarah".
+		d = min(d, levenshtein(key, string(prefix[:n])))
+	}
+	if d > n/4 {
+		return 0
+	}
+	return 0.7 * (1 - float64(d)/float64(n))
+}
+
+// normaliseName lowercases name, drops punctuation and (optionally) a leading
+// article, then folds transliteration variants and doubled letters.
+func normaliseName(name string, dropArticle bool) string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	if dropArticle && len(fields) > 1 {
+		if _, ok := articles[fields[0]]; ok {
+			fields = fields[1:]
+		}
+	}
+	if dropArticle && len(fields) > 0 && strings.HasPrefix(fields[0], arabicArticle) && len([]rune(fields[0])) > 3 {
+		fields[0] = strings.TrimPrefix(fields[0], arabicArticle)
+	}
+
+	folded := foldings.Replace(strings.Join(fields, ""))
+
+	var b strings.Builder
+	var prev rune
+	for _, r := range folded {
+		if r != prev {
+			b.WriteRune(r)
+		}
+		prev = r
+	}
+
+	key := b.String()
+	if len(key) > 3 && strings.HasSuffix(key, "ah") {
+		key = strings.TrimSuffix(key, "h")
+	}
+	return key
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,361 @@
+// Package reference parses human-written verse references ("2:255",
+// "QS 2:1-5", "Al-Baqarah 255", "yasin 1-12", "2:286-3:5") into normalised
+// spans of ayahs.
+//
+// Usage:
+//
+//	spans, err := reference.Parse(c.Query("q"))
+//	if err != nil {
+//	    response.BadRequest(c, domain.CodeInvalidReference, err.Error(), response.Param("q"))
+//	    return
+//	}
+//	for _, s := range spans {
+//	    // fetch ayahs s.From..s.To of surah s.SurahID
+//	}
+package reference
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"quran-api-go/internal/domain"
+)
+
+// TotalAyahs is the number of ayahs in the mushaf.
+const TotalAyahs = 6236
+
+// MaxAyahs caps how many ayahs a single reference may be resolved to by an
+// API (REST /ref, the MCP tool), enough for the longest surah (Al-Baqarah,
+// 286 ayahs). Check it with Len before fetching anything.
+const MaxAyahs = 300
+
+// Error is a reference Parse rejected. It wraps domain.ErrInvalidReference
+// and keeps the format and arguments of its message, so callers can
+// localise the format:
+//
+//	var refErr *reference.Error
+//	if errors.As(err, &refErr) {
+//	    response.BadRequestf(c, domain.CodeInvalidReference, nil, refErr.Format, refErr.Args...)
+//	}
+type Error struct {
+	Format string
+	Args   []any
+}
+
+func invalidf(format string, args ...any) *Error {
+	return &Error{Format: format, Args: args}
+}
+
+func (e *Error) Error() string { return fmt.Sprintf(e.Format, e.Args...) }
+
+func (e *Error) Unwrap() error { return domain.ErrInvalidReference }
+
+// Span is a contiguous run of ayahs within a single surah.
+type Span struct {
+	SurahID int `json:"surah_id"`
+	From    int `json:"from"`
+	To      int `json:"to"`
+}
+
+// Len returns the number of ayahs covered by the span.
+func (s Span) Len() int {
+	return s.To - s.From + 1
+}
+
+// Len returns the number of ayahs covered by spans together.
+func Len(spans []Span) int {
+	n := 0
+	for _, span := range spans {
+		n += span.Len()
+	}
+	return n
+}
+
+// String formats the span as "2:255" or "2:255-257".
+func (s Span) String() string {
+	if s.From == s.To {
+		return fmt.Sprintf("%d:%d", s.SurahID, s.From)
+	}
+	return fmt.Sprintf("%d:%d-%d", s.SurahID, s.From, s.To)
+}
+
+// AyahCount returns the number of ayahs in a surah, or 0 for an unknown surah.
+func AyahCount(surahID int) int {
+	if surahID < 1 || surahID > len(surahNames) {
+		return 0
+	}
+	return surahNames[surahID-1].Ayahs
+}
+
+// surahOffsets[i] is the global ID of the ayah before surah i+1's first ayah.
+var surahOffsets = func() [114]int {
+	var offsets [114]int
+	for i := 1; i < len(surahNames); i++ {
+		offsets[i] = offsets[i-1] + surahNames[i-1].Ayahs
+	}
+	return offsets
+}()
+
+// GlobalID converts a surah number and ayah number within it to the ayah's
+// global ID (1-6236). It reports false when either number is out of range.
+func GlobalID(surahID, number int) (int, bool) {
+	if number < 1 || number > AyahCount(surahID) {
+		return 0, false
+	}
+	return surahOffsets[surahID-1] + number, true
+}
+
+// Locate converts a global ayah ID (1-6236) back to its surah number and
+// ayah number within that surah.
+func Locate(globalID int) (surahID, number int, ok bool) {
+	if globalID < 1 || globalID > TotalAyahs {
+		return 0, 0, false
+	}
+	surahID = sort.Search(len(surahOffsets), func(i int) bool {
+		return surahOffsets[i] >= globalID
+	})
+	return surahID, globalID - surahOffsets[surahID-1], true
+}
+
+// SurahName returns the transliterated name of a surah: the Kemenag spelling
+// ("Al-Baqarah") for lang "id" and the international one ("Al-Baqara")
+// otherwise. Returns "" for an unknown surah.
+func SurahName(surahID int, lang string) string {
+	if surahID < 1 || surahID > len(surahNames) {
+		return ""
+	}
+	if lang == "id" {
+		return surahNames[surahID-1].Latin
+	}
+	return surahNames[surahID-1].English
+}
+
+// SurahSlug returns the URL slug of a surah, its Kemenag name in lower case
+// with words joined by hyphens ("al-baqarah", "ali-imran", "yasin").
+// Returns "" for an unknown surah.
+func SurahSlug(surahID int) string {
+	name := SurahName(surahID, "id")
+	fields := strings.FieldsFunc(strings.ToLower(strings.ReplaceAll(name, "'", "")), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	return strings.Join(fields, "-")
+}
+
+var (
+	prefixRe  = regexp.MustCompile(`(?i)^\s*(?:q\.?\s?s\b\.?|surah\b|surat\b|sura\b)[\s.:]*`)
+	wordsRe   = regexp.MustCompile(`(?i)\b(?:ayat|ayah|ayahs|verse|verses)\b`)
+	rangeRe   = regexp.MustCompile(`(?i)(\d)\s*(?:s\.?\s?d\.?|sampai|to)\s*`)
+	bracketRe = regexp.MustCompile(`\[\s*(\d+)\s*\]`)
+	keyRe     = regexp.MustCompile(`^(\d+)\s*[:.]\s*(\d+)$`)
+	numberRe  = regexp.MustCompile(`^\d+$`)
+	namedRe   = regexp.MustCompile(`^(.*?)[\s:]*(\d+(?:\s*[:.]\s*\d+)?)?$`)
+)
+
+// point is one side of a reference before it is resolved against context.
+// bare holds a lone number whose meaning (surah or ayah) depends on where it
+// appears; a zero ayah means "the whole surah".
+type point struct {
+	surah int
+	ayah  int
+	bare  int
+}
+
+// Parse converts a free-form reference into spans in the order written.
+// Comma- or semicolon-separated items continue the previous surah, so
+// "2:255, 257" means 2:255 and 2:257. Ranges that cross a surah boundary
+// are split into one span per surah.
+//
+// Returns an *Error, which wraps domain.ErrInvalidReference, when the input
+// cannot be parsed or points outside the mushaf.
+func Parse(q string) ([]Span, error) {
+	q = strings.NewReplacer("–", "-", "—", "-", "(", " ", ")", " ").Replace(q)
+	q = prefixRe.ReplaceAllString(q, "")
+	q = wordsRe.ReplaceAllString(q, " ")
+	q = rangeRe.ReplaceAllString(q, "$1-")
+
+	items := strings.FieldsFunc(q, func(r rune) bool { return r == ',' || r == ';' })
+	if len(items) == 0 {
+		return nil, invalidf("invalid verse reference: empty reference")
+	}
+
+	var (
+		spans   []Span
+		current int // surah in effect for continuation items
+	)
+	for _, item := range items {
+		item = prefixRe.ReplaceAllString(strings.TrimSpace(item), "")
+		if item == "" {
+			continue
+		}
+
+		leftRaw, rightRaw, isRange := splitRange(item)
+		left, err := parsePoint(leftRaw)
+		if err != nil {
+			return nil, err
+		}
+		if left.bare > 0 {
+			if current > 0 {
+				left = point{surah: current, ayah: left.bare}
+			} else {
+				left = point{surah: left.bare}
+			}
+		}
+
+		right := left
+		if isRange {
+			right, err = parsePoint(rightRaw)
+			if err != nil {
+				return nil, err
+			}
+			if right.bare > 0 {
+				if left.ayah > 0 {
+					right = point{surah: left.surah, ayah: right.bare}
+				} else {
+					right = point{surah: right.bare}
+				}
+			}
+		}
+
+		resolved, err := resolve(left, right, strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+		spans = append(spans, resolved...)
+		current = right.surah
+	}
+
+	if len(spans) == 0 {
+		return nil, invalidf("invalid verse reference: empty reference")
+	}
+	return spans, nil
+}
+
+// splitRange splits item on the first dash that follows a digit. Dashes
+// inside names ("Al-Baqarah") are left alone.
+func splitRange(item string) (string, string, bool) {
+	for i, r := range item {
+		if r != '-' {
+			continue
+		}
+		before := strings.TrimSpace(item[:i])
+		if before == "" {
+			continue
+		}
+		if last := before[len(before)-1]; last >= '0' && last <= '9' {
+			return before, strings.TrimSpace(item[i+1:]), true
+		}
+	}
+	return item, "", false
+}
+
+func parsePoint(raw string) (point, error) {
+	raw = strings.TrimSpace(raw)
+
+	bracket := 0
+	if m := bracketRe.FindStringSubmatch(raw); m != nil {
+		bracket = atoi(m[1])
+		raw = strings.TrimSpace(bracketRe.ReplaceAllString(raw, " "))
+	}
+
+	if m := keyRe.FindStringSubmatch(raw); m != nil {
+		s, a := atoi(m[1]), atoi(m[2])
+		if s == 0 || a == 0 {
+			return point{}, invalidf("invalid verse reference: numbers start at 1 in %q", raw)
+		}
+		return point{surah: s, ayah: a}, nil
+	}
+	if numberRe.MatchString(raw) {
+		n := atoi(raw)
+		if n == 0 {
+			return point{}, invalidf("invalid verse reference: numbers start at 1 in %q", raw)
+		}
+		if bracket > 0 {
+			return point{surah: bracket, ayah: n}, nil
+		}
+		return point{bare: n}, nil
+	}
+	if raw == "" && bracket > 0 {
+		return point{surah: bracket}, nil
+	}
+
+	m := namedRe.FindStringSubmatch(raw)
+	name := strings.Trim(m[1], " :.")
+	if name == "" {
+		return point{}, invalidf("invalid verse reference: cannot parse %q", raw)
+	}
+
+	var p point
+	if tail := m[2]; tail != "" {
+		if k := keyRe.FindStringSubmatch(tail); k != nil {
+			p.surah, p.ayah = atoi(k[1]), atoi(k[2])
+		} else {
+			p.ayah = atoi(tail)
+		}
+		if p.ayah == 0 {
+			return point{}, invalidf("invalid verse reference: numbers start at 1 in %q", raw)
+		}
+	}
+
+	switch {
+	case bracket > 0:
+		p.surah = bracket
+	case p.surah > 0:
+		// "Al-Baqarah 2:255": the explicit number wins over the name.
+	default:
+		n, ok := MatchSurah(name)
+		if !ok {
+			return point{}, invalidf("invalid verse reference: unknown surah %q", name)
+		}
+		p.surah = n
+	}
+	return p, nil
+}
+
+// resolve turns a start and end point into per-surah spans, validating both
+// against the mushaf. item is the reference they were parsed from, quoted in
+// errors.
+func resolve(from, to point, item string) ([]Span, error) {
+	for _, p := range []point{from, to} {
+		count := AyahCount(p.surah)
+		if count == 0 {
+			return nil, invalidf("invalid verse reference: surah %d does not exist: %q", p.surah, item)
+		}
+		if p.ayah > count {
+			return nil, invalidf("invalid verse reference: surah %d has %d ayahs: %q", p.surah, count, item)
+		}
+	}
+
+	startAyah := max(from.ayah, 1)
+	endAyah := to.ayah
+	if endAyah == 0 {
+		endAyah = AyahCount(to.surah)
+	}
+	if from.surah > to.surah || (from.surah == to.surah && startAyah > endAyah) {
+		return nil, invalidf("invalid verse reference: range end is before its start: %q", item)
+	}
+
+	if from.surah == to.surah {
+		return []Span{{SurahID: from.surah, From: startAyah, To: endAyah}}, nil
+	}
+
+	spans := []Span{{SurahID: from.surah, From: startAyah, To: AyahCount(from.surah)}}
+	for s := from.surah + 1; s < to.surah; s++ {
+		spans = append(spans, Span{SurahID: s, From: 1, To: AyahCount(s)})
+	}
+	spans = append(spans, Span{SurahID: to.surah, From: 1, To: endAyah})
+	return spans, nil
+}
+
+// atoi parses a run of digits already matched by a regexp. Values too large
+// to be a surah or ayah number are clamped so they fail range validation.
+func atoi(s string) int {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return TotalAyahs + 1
+	}
+	return n
+}
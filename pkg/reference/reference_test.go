@@ -0,0 +1,268 @@
+package reference
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"quran-api-go/internal/domain"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		q    string
+		want []Span
+	}{
+		{"numeric single", "2:255", []Span{{2, 255, 255}}},
+		{"QS prefix with range", "QS 2:1-5", []Span{{2, 1, 5}}},
+		{"Q.S. with dot", "Q.S. 2:255", []Span{{2, 255, 255}}},
+		{"whole surah by number", "112", []Span{{112, 1, 4}}},
+		{"name with ayah", "Al-Baqarah 255", []Span{{2, 255, 255}}},
+		{"lowercase name with range", "yasin 1-12", []Span{{36, 1, 12}}},
+		{"name only is whole surah", "Al-Fatihah", []Span{{1, 1, 7}}},
+		{"Kemenag style", "QS. Al-Baqarah [2]: 255", []Span{{2, 255, 255}}},
+		{"parenthesised citation", "(Al-Baqarah 2:255)", []Span{{2, 255, 255}}},
+		{"comma list continues surah", "2:255, 257", []Span{{2, 255, 255}, {2, 257, 257}}},
+		{"comma list across surahs", "2:255; 3:1-2", []Span{{2, 255, 255}, {3, 1, 2}}},
+		{"cross-surah range", "2:286-3:5", []Span{{2, 286, 286}, {3, 1, 5}}},
+		{"cross-surah range spanning whole surah", "112:4-114:1", []Span{{112, 4, 4}, {113, 1, 5}, {114, 1, 1}}},
+		{"indonesian s.d.", "Al-Mulk 1 s.d. 5", []Span{{67, 1, 5}}},
+		{"surah word prefix", "Surah Ar-Rahman 13", []Span{{55, 13, 13}}},
+		{"spelling variant", "Yaasiin 1", []Span{{36, 1, 1}}},
+		{"english transliteration", "Ash-Shams 1-3", []Span{{91, 1, 3}}},
+		{"article without dash", "Al Ikhlas", []Span{{112, 1, 4}}},
+		{"article fused", "annas 1", []Span{{114, 1, 1}}},
+		{"pesantren spelling", "Al-Baqoroh 1", []Span{{2, 1, 1}}},
+		{"fuzzy typo", "Al-Baqarh 1", []Span{{2, 1, 1}}},
+		{"fuzzy typo longer name", "Al-Mutafifin 1", []Span{{83, 1, 1}}},
+		{"arabic name", "البقرة 255", []Span{{2, 255, 255}}},
+		{"english meaning", "The Cow 255", []Span{{2, 255, 255}}},
+		{"en dash", "2:1–3", []Span{{2, 1, 3}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Parse(tc.q)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tc.q, err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tc.q, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"   ",
+		"115:1",
+		"2:287",
+		"2:0",
+		"2:10-5",
+		"3:1-2:5",
+		"not a surah 5",
+		"imran 5", // close to the meaning "The Iron", but meanings never match fuzzily
+		"1:99999999999999999999",
+	}
+
+	for _, q := range tests {
+		t.Run(q, func(t *testing.T) {
+			_, err := Parse(q)
+			if !errors.Is(err, domain.ErrInvalidReference) {
+				t.Errorf("Parse(%q): expected ErrInvalidReference, got %v", q, err)
+			}
+		})
+	}
+}
+
+func TestSurahTable(t *testing.T) {
+	total := 0
+	for i, s := range surahNames {
+		if s.Number != i+1 {
+			t.Errorf("surahNames[%d].Number = %d", i, s.Number)
+		}
+		total += s.Ayahs
+	}
+	if total != TotalAyahs {
+		t.Errorf("total ayahs = %d, want %d", total, TotalAyahs)
+	}
+}
+
+func TestNameIndex_NoCollisions(t *testing.T) {
+	seen := map[string]int{}
+	for _, s := range surahNames {
+		names := append([]string{s.Latin, s.English, s.Arabic}, s.Aliases...)
+		if s.Meaning != "" {
+			names = append(names, s.Meaning)
+		}
+		for _, name := range names {
+			for _, key := range []string{normaliseName(name, true), normaliseName(name, false)} {
+				if other, ok := seen[key]; ok && other != s.Number {
+					t.Errorf("key %q maps to both %d and %d", key, other, s.Number)
+				}
+				seen[key] = s.Number
+			}
+		}
+	}
+}
+
+func TestMatchSurah(t *testing.T) {
+	tests := []struct {
+		name string
+		want int
+	}{
+		{"Yaasiin", 36},
+		{"Ya Sin", 36},
+		{"Yasin", 36},
+		{"ya-sin", 36},
+		{"يس", 36},
+		{"البقرة", 2},
+		{"الْبَقَرَة", 2},
+		{"الإسراء", 17},
+		{"الاسراء", 17},
+		{"The Cow", 2},
+		{"The Opening", 1},
+		{"the night journey", 17},
+		{"Al-Baqoroh", 2},
+		{"Asy-Syams", 91},
+		{"Taha", 20},
+		{"Thaha", 20},
+		{"Thoha", 20},
+		{"Ali Imran", 3},
+	}
+	for _, tc := range tests {
+		if got, ok := MatchSurah(tc.name); !ok || got != tc.want {
+			t.Errorf("MatchSurah(%q) = %d, %v, want %d", tc.name, got, ok, tc.want)
+		}
+	}
+
+	for _, name := range []string{"foo", "", "The Iron Imran"} {
+		if got, ok := MatchSurah(name); ok {
+			t.Errorf("MatchSurah(%q) = %d, want no match", name, got)
+		}
+	}
+}
+
+func TestSearchSurahs(t *testing.T) {
+	tests := []struct {
+		q    string
+		want []int
+	}{
+		{"Yaasiin", []int{36}},
+		{"Ya Sin", []int{36}},
+		{"يس", []int{36}},
+		{"الْبَقَرَة", []int{2}},
+		{"cow", []int{2, 100}},
+		{"baq", []int{2}},
+		{"baqr", []int{2}},
+		{"imran", []int{3}},
+		{"nas", []int{114, 110, 94}},
+		{"the cave", []int{18}},
+		{"maryam", []int{19}},
+		{"kahf", []int{18, 50}},
+		{"a", nil},
+		{"al", nil},
+		{"xyz", nil},
+		{"", nil},
+		// Dataset names such as the Indonesian meanings are not indexed.
+		{"pembukaan", nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.q, func(t *testing.T) {
+			var got []int
+			for _, m := range SearchSurahs(tc.q, 3) {
+				got = append(got, m.Number)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("SearchSurahs(%q) = %v, want %v", tc.q, got, tc.want)
+			}
+		})
+	}
+
+	t.Run("exact match scores 1", func(t *testing.T) {
+		got := SearchSurahs("The Cow", 1)
+		if len(got) != 1 || got[0].Score != 1 || got[0].Name != "The Cow" {
+			t.Errorf("got %+v", got)
+		}
+	})
+}
+
+func TestSurahSlug(t *testing.T) {
+	tests := map[int]string{1: "al-fatihah", 2: "al-baqarah", 3: "ali-imran", 36: "yasin", 112: "al-ikhlas", 115: ""}
+	for id, want := range tests {
+		if got := SurahSlug(id); got != want {
+			t.Errorf("SurahSlug(%d) = %q, want %q", id, got, want)
+		}
+	}
+	for id := 1; id <= 114; id++ {
+		if n, ok := MatchSurah(SurahSlug(id)); !ok || n != id {
+			t.Errorf("MatchSurah(%q) = %d, %v, want %d", SurahSlug(id), n, ok, id)
+		}
+	}
+}
+
+func TestSpanString(t *testing.T) {
+	if got := (Span{2, 255, 255}).String(); got != "2:255" {
+		t.Errorf("got %q", got)
+	}
+	if got := (Span{2, 1, 5}).String(); got != "2:1-5" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestLen(t *testing.T) {
+	if got := Len([]Span{{2, 255, 257}, {112, 1, 4}}); got != 7 {
+		t.Errorf("Len = %d, want 7", got)
+	}
+	if got := Len(nil); got != 0 {
+		t.Errorf("Len(nil) = %d, want 0", got)
+	}
+}
+
+func TestGlobalID(t *testing.T) {
+	tests := []struct {
+		surah, ayah int
+		want        int
+		ok          bool
+	}{
+		{1, 1, 1, true},
+		{2, 1, 8, true},
+		{2, 255, 262, true},
+		{114, 6, TotalAyahs, true},
+		{1, 8, 0, false},
+		{0, 1, 0, false},
+		{115, 1, 0, false},
+	}
+	for _, tc := range tests {
+		got, ok := GlobalID(tc.surah, tc.ayah)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("GlobalID(%d, %d) = %d, %v; want %d, %v", tc.surah, tc.ayah, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestLocate(t *testing.T) {
+	tests := []struct {
+		global        int
+		surah, number int
+		ok            bool
+	}{
+		{1, 1, 1, true},
+		{7, 1, 7, true},
+		{8, 2, 1, true},
+		{262, 2, 255, true},
+		{TotalAyahs, 114, 6, true},
+		{0, 0, 0, false},
+		{TotalAyahs + 1, 0, 0, false},
+	}
+	for _, tc := range tests {
+		surah, number, ok := Locate(tc.global)
+		if surah != tc.surah || number != tc.number || ok != tc.ok {
+			t.Errorf("Locate(%d) = %d, %d, %v; want %d, %d, %v", tc.global, surah, number, ok, tc.surah, tc.number, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package reference
+
+// surahName holds the spellings a surah is commonly referred to by.
+// Latin is the Kemenag (Indonesian) transliteration, English is the common
+// international transliteration, Arabic is the name in Arabic script,
+// Meaning is its English translation (empty for the surahs named after
+// letters) and Aliases lists alternative names.
+type surahName struct {
+	Number  int
+	Ayahs   int
+	Latin   string
+	English string
+	Arabic  string
+	Meaning string
+	Aliases []string
+}
+
+// surahNames is indexed by surah number - 1.
+var surahNames = [114]surahName{
+	{1, 7, "Al-Fatihah", "Al-Fatiha", "الفاتحة", "The Opening", []string{"Ummul Kitab", "Ummul Quran"}},
+	{2, 286, "Al-Baqarah", "Al-Baqara", "البقرة", "The Cow", nil},
+	{3, 200, "Ali 'Imran", "Aal-i-Imran", "آل عمران", "The Family of Imran", nil},
+	{4, 176, "An-Nisa'", "An-Nisa", "النساء", "The Women", nil},
+	{5, 120, "Al-Ma'idah", "Al-Maida", "المائدة", "The Table Spread", nil},
+	{6, 165, "Al-An'am", "Al-Anam", "الأنعام", "The Cattle", nil},
+	{7, 206, "Al-A'raf", "Al-Araf", "الأعراف", "The Heights", nil},
+	{8, 75, "Al-Anfal", "Al-Anfal", "الأنفال", "The Spoils of War", nil},
+	{9, 129, "At-Taubah", "At-Tawba", "التوبة", "The Repentance", []string{"Bara'ah"}},
+	{10, 109, "Yunus", "Yunus", "يونس", "Jonah", nil},
+	{11, 123, "Hud", "Hud", "هود", "Hud", nil},
+	{12, 111, "Yusuf", "Yusuf", "يوسف", "Joseph", nil},
+	{13, 43, "Ar-Ra'd", "Ar-Rad", "الرعد", "The Thunder", nil},
+	{14, 52, "Ibrahim", "Ibrahim", "إبراهيم", "Abraham", nil},
+	{15, 99, "Al-Hijr", "Al-Hijr", "الحجر", "The Rocky Tract", nil},
+	{16, 128, "An-Nahl", "An-Nahl", "النحل", "The Bee", nil},
+	{17, 111, "Al-Isra'", "Al-Isra", "الإسراء", "The Night Journey", []string{"Bani Isra'il"}},
+	{18, 110, "Al-Kahf", "Al-Kahf", "الكهف", "The Cave", nil},
+	{19, 98, "Maryam", "Maryam", "مريم", "Mary", nil},
+	{20, 135, "Taha", "Ta-Ha", "طه", "", []string{"Thaha", "Thoha"}},
+	{21, 112, "Al-Anbiya'", "Al-Anbiya", "الأنبياء", "The Prophets", nil},
+	{22, 78, "Al-Hajj", "Al-Hajj", "الحج", "The Pilgrimage", nil},
+	{23, 118, "Al-Mu'minun", "Al-Muminun", "المؤمنون", "The Believers", nil},
+	{24, 64, "An-Nur", "An-Nur", "النور", "The Light", nil},
+	{25, 77, "Al-Furqan", "Al-Furqan", "الفرقان", "The Criterion", nil},
+	{26, 227, "Asy-Syu'ara'", "Ash-Shuara", "الشعراء", "The Poets", nil},
+	{27, 93, "An-Naml", "An-Naml", "النمل", "The Ant", nil},
+	{28, 88, "Al-Qasas", "Al-Qasas", "القصص", "The Stories", nil},
+	{29, 69, "Al-'Ankabut", "Al-Ankabut", "العنكبوت", "The Spider", nil},
+	{30, 60, "Ar-Rum", "Ar-Rum", "الروم", "The Romans", nil},
+	{31, 34, "Luqman", "Luqman", "لقمان", "Luqman", nil},
+	{32, 30, "As-Sajdah", "As-Sajda", "السجدة", "The Prostration", nil},
+	{33, 73, "Al-Ahzab", "Al-Ahzab", "الأحزاب", "The Confederates", nil},
+	{34, 54, "Saba'", "Saba", "سبإ", "Sheba", nil},
+	{35, 45, "Fatir", "Fatir", "فاطر", "The Originator", []string{"Al-Mala'ikah"}},
+	{36, 83, "Yasin", "Ya-Sin", "يس", "", nil},
+	{37, 182, "As-Saffat", "As-Saffat", "الصافات", "Those Ranged in Ranks", nil},
+	{38, 88, "Sad", "Sad", "ص", "", nil},
+	{39, 75, "Az-Zumar", "Az-Zumar", "الزمر", "The Troops", nil},
+	{40, 85, "Gafir", "Ghafir", "غافر", "The Forgiver", []string{"Al-Mu'min"}},
+	{41, 54, "Fussilat", "Fussilat", "فصلت", "Explained in Detail", []string{"Ha Mim Sajdah"}},
+	{42, 53, "Asy-Syura", "Ash-Shura", "الشورى", "The Consultation", nil},
+	{43, 89, "Az-Zukhruf", "Az-Zukhruf", "الزخرف", "The Ornaments of Gold", nil},
+	{44, 59, "Ad-Dukhan", "Ad-Dukhan", "الدخان", "The Smoke", nil},
+	{45, 37, "Al-Jasiyah", "Al-Jathiya", "الجاثية", "The Crouching", nil},
+	{46, 35, "Al-Ahqaf", "Al-Ahqaf", "الأحقاف", "The Wind-Curved Sandhills", nil},
+	{47, 38, "Muhammad", "Muhammad", "محمد", "Muhammad", []string{"Al-Qital"}},
+	{48, 29, "Al-Fath", "Al-Fath", "الفتح", "The Victory", nil},
+	{49, 18, "Al-Hujurat", "Al-Hujurat", "الحجرات", "The Rooms", nil},
+	{50, 45, "Qaf", "Qaf", "ق", "", nil},
+	{51, 60, "Az-Zariyat", "Adh-Dhariyat", "الذاريات", "The Winnowing Winds", nil},
+	{52, 49, "At-Tur", "At-Tur", "الطور", "The Mount", nil},
+	{53, 62, "An-Najm", "An-Najm", "النجم", "The Star", nil},
+	{54, 55, "Al-Qamar", "Al-Qamar", "القمر", "The Moon", nil},
+	{55, 78, "Ar-Rahman", "Ar-Rahman", "الرحمن", "The Most Merciful", nil},
+	{56, 96, "Al-Waqi'ah", "Al-Waqia", "الواقعة", "The Inevitable", nil},
+	{57, 29, "Al-Hadid", "Al-Hadid", "الحديد", "The Iron", nil},
+	{58, 22, "Al-Mujadilah", "Al-Mujadila", "المجادلة", "The Pleading Woman", nil},
+	{59, 24, "Al-Hasyr", "Al-Hashr", "الحشر", "The Exile", nil},
+	{60, 13, "Al-Mumtahanah", "Al-Mumtahina", "الممتحنة", "She That Is to Be Examined", nil},
+	{61, 14, "As-Saff", "As-Saff", "الصف", "The Ranks", nil},
+	{62, 11, "Al-Jumu'ah", "Al-Jumua", "الجمعة", "Friday", nil},
+	{63, 11, "Al-Munafiqun", "Al-Munafiqun", "المنافقون", "The Hypocrites", nil},
+	{64, 18, "At-Tagabun", "At-Taghabun", "التغابن", "The Mutual Disillusion", nil},
+	{65, 12, "At-Talaq", "At-Talaq", "الطلاق", "The Divorce", nil},
+	{66, 12, "At-Tahrim", "At-Tahrim", "التحريم", "The Prohibition", nil},
+	{67, 30, "Al-Mulk", "Al-Mulk", "الملك", "The Sovereignty", nil},
+	{68, 52, "Al-Qalam", "Al-Qalam", "القلم", "The Pen", []string{"Nun"}},
+	{69, 52, "Al-Haqqah", "Al-Haqqa", "الحاقة", "The Reality", nil},
+	{70, 44, "Al-Ma'arij", "Al-Maarij", "المعارج", "The Ascending Stairways", nil},
+	{71, 28, "Nuh", "Nuh", "نوح", "Noah", nil},
+	{72, 28, "Al-Jinn", "Al-Jinn", "الجن", "The Jinn", nil},
+	{73, 20, "Al-Muzzammil", "Al-Muzzammil", "المزمل", "The Enshrouded One", nil},
+	{74, 56, "Al-Muddassir", "Al-Muddaththir", "المدثر", "The Cloaked One", nil},
+	{75, 40, "Al-Qiyamah", "Al-Qiyama", "القيامة", "The Resurrection", nil},
+	{76, 31, "Al-Insan", "Al-Insan", "الإنسان", "Man", []string{"Ad-Dahr"}},
+	{77, 50, "Al-Mursalat", "Al-Mursalat", "المرسلات", "Those Sent Forth", nil},
+	{78, 40, "An-Naba'", "An-Naba", "النبإ", "The Tidings", nil},
+	{79, 46, "An-Nazi'at", "An-Naziat", "النازعات", "Those Who Drag Forth", nil},
+	{80, 42, "'Abasa", "Abasa", "عبس", "He Frowned", nil},
+	{81, 29, "At-Takwir", "At-Takwir", "التكوير", "The Overthrowing", nil},
+	{82, 19, "Al-Infitar", "Al-Infitar", "الانفطار", "The Cleaving", nil},
+	{83, 36, "Al-Mutaffifin", "Al-Mutaffifin", "المطففين", "The Defrauding", nil},
+	{84, 25, "Al-Insyiqaq", "Al-Inshiqaq", "الانشقاق", "The Splitting Open", nil},
+	{85, 22, "Al-Buruj", "Al-Buruj", "البروج", "The Mansions of the Stars", nil},
+	{86, 17, "At-Tariq", "At-Tariq", "الطارق", "The Nightcomer", nil},
+	{87, 19, "Al-A'la", "Al-Ala", "الأعلى", "The Most High", nil},
+	{88, 26, "Al-Gasyiyah", "Al-Ghashiya", "الغاشية", "The Overwhelming", nil},
+	{89, 30, "Al-Fajr", "Al-Fajr", "الفجر", "The Dawn", nil},
+	{90, 20, "Al-Balad", "Al-Balad", "البلد", "The City", nil},
+	{91, 15, "Asy-Syams", "Ash-Shams", "الشمس", "The Sun", nil},
+	{92, 21, "Al-Lail", "Al-Layl", "الليل", "The Night", nil},
+	{93, 11, "Ad-Duha", "Ad-Duha", "الضحى", "The Morning Hours", nil},
+	{94, 8, "Asy-Syarh", "Ash-Sharh", "الشرح", "The Relief", []string{"Al-Insyirah", "Alam Nasyrah"}},
+	{95, 8, "At-Tin", "At-Tin", "التين", "The Fig", nil},
+	{96, 19, "Al-'Alaq", "Al-Alaq", "العلق", "The Clot", []string{"Iqra'"}},
+	{97, 5, "Al-Qadr", "Al-Qadr", "القدر", "The Power", nil},
+	{98, 8, "Al-Bayyinah", "Al-Bayyina", "البينة", "The Clear Proof", nil},
+	{99, 8, "Az-Zalzalah", "Az-Zalzala", "الزلزلة", "The Earthquake", nil},
+	{100, 11, "Al-'Adiyat", "Al-Adiyat", "العاديات", "The Courser", nil},
+	{101, 11, "Al-Qari'ah", "Al-Qaria", "القارعة", "The Calamity", nil},
+	{102, 8, "At-Takasur", "At-Takathur", "التكاثر", "The Rivalry in World Increase", nil},
+	{103, 3, "Al-'Asr", "Al-Asr", "العصر", "The Declining Day", nil},
+	{104, 9, "Al-Humazah", "Al-Humaza", "الهمزة", "The Traducer", nil},
+	{105, 5, "Al-Fil", "Al-Fil", "الفيل", "The Elephant", nil},
+	{106, 4, "Quraisy", "Quraysh", "قريش", "Quraysh", nil},
+	{107, 7, "Al-Ma'un", "Al-Maun", "الماعون", "The Small Kindnesses", nil},
+	{108, 3, "Al-Kausar", "Al-Kawthar", "الكوثر", "The Abundance", nil},
+	{109, 6, "Al-Kafirun", "Al-Kafirun", "الكافرون", "The Disbelievers", nil},
+	{110, 3, "An-Nasr", "An-Nasr", "النصر", "The Divine Support", nil},
+	{111, 5, "Al-Lahab", "Al-Masad", "المسد", "The Palm Fiber", []string{"Tabbat"}},
+	{112, 4, "Al-Ikhlas", "Al-Ikhlas", "الإخلاص", "The Sincerity", nil},
+	{113, 5, "Al-Falaq", "Al-Falaq", "الفلق", "The Daybreak", nil},
+	{114, 6, "An-Nas", "An-Nas", "الناس", "Mankind", nil},
+}
@@ -0,0 +1,209 @@
+package response
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"quran-api-go/pkg/validator"
+)
+
+// Format is an output format for list responses.
+type Format string
+
+const (
+	FormatJSON     Format = "json"
+	FormatCSV      Format = "csv"
+	FormatNDJSON   Format = "ndjson"
+	FormatMarkdown Format = "markdown"
+	FormatText     Format = "text"
+)
+
+// ErrInvalidFormat is returned by NegotiateFormat for an unknown ?format=.
+var ErrInvalidFormat = errors.New("format must be one of json, csv, ndjson, markdown or text")
+
+var formatAliases = map[string]Format{
+	"json":     FormatJSON,
+	"csv":      FormatCSV,
+	"ndjson":   FormatNDJSON,
+	"jsonl":    FormatNDJSON,
+	"markdown": FormatMarkdown,
+	"md":       FormatMarkdown,
+	"text":     FormatText,
+	"txt":      FormatText,
+}
+
+var formatMediaTypes = map[string]Format{
+	"application/json":     FormatJSON,
+	"text/csv":             FormatCSV,
+	"application/x-ndjson": FormatNDJSON,
+	"application/ndjson":   FormatNDJSON,
+	"text/markdown":        FormatMarkdown,
+	"text/plain":           FormatText,
+}
+
+var formatContentTypes = map[Format]string{
+	FormatCSV:      "text/csv; charset=utf-8",
+	FormatNDJSON:   "application/x-ndjson; charset=utf-8",
+	FormatMarkdown: "text/markdown; charset=utf-8",
+	FormatText:     "text/plain; charset=utf-8",
+}
+
+// NegotiateFormat picks the output format from ?format= or, when absent, the
+// Accept header (highest q-value wins, JSON on ties and wildcards).
+//
+// Usage:
+//
+//	format, err := response.NegotiateFormat(c)
+//	if err != nil {
+//	    response.BadRequest(c, domain.CodeInvalidFormat, err.Error(), response.Param("format"))
+//	    return
+//	}
+func NegotiateFormat(c *gin.Context) (Format, error) {
+	if raw := c.Query("format"); raw != "" {
+		format, ok := formatAliases[strings.ToLower(raw)]
+		if !ok {
+			return "", ErrInvalidFormat
+		}
+		return format, nil
+	}
+
+	c.Writer.Header().Add("Vary", "Accept")
+	best, bestQ := FormatJSON, 0.0
+	for _, part := range strings.Split(c.GetHeader("Accept"), ",") {
+		mediaType, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		format, ok := formatMediaTypes[strings.ToLower(strings.TrimSpace(mediaType))]
+		if !ok {
+			continue
+		}
+		q, ok := validator.QValue(params)
+		if !ok {
+			continue
+		}
+		if q > bestQ {
+			best, bestQ = format, q
+		}
+	}
+	return best, nil
+}
+
+// Table is a list response in a shape that renders without the JSON
+// envelope: CSV and Markdown tables use Columns and Values, NDJSON writes
+// each Item as one line, and Markdown quotes and plain text use Text
+// followed by a citation line when rows have one.
+type Table struct {
+	Columns []string
+	Rows    []TableRow
+}
+
+// TableRow is one record of a Table.
+type TableRow struct {
+	Values   []string // one per column
+	Item     any      // NDJSON line
+	Text     []string // prose lines, e.g. Arabic text and translation
+	Citation string   // e.g. "QS. Al-Fatihah [1]: 1"
+}
+
+// Select keeps only the named columns, in table order. An empty list keeps
+// every column.
+func (t Table) Select(columns []string) Table {
+	if len(columns) == 0 {
+		return t
+	}
+	wanted := make(map[string]struct{}, len(columns))
+	for _, col := range columns {
+		wanted[col] = struct{}{}
+	}
+
+	var keep []int
+	selected := Table{}
+	for i, col := range t.Columns {
+		if _, ok := wanted[col]; ok {
+			keep = append(keep, i)
+			selected.Columns = append(selected.Columns, col)
+		}
+	}
+	for _, row := range t.Rows {
+		values := make([]string, 0, len(keep))
+		for _, i := range keep {
+			values = append(values, row.Values[i])
+		}
+		row.Values = values
+		selected.Rows = append(selected.Rows, row)
+	}
+	return selected
+}
+
+// Render writes table in format with a 200 status. FormatJSON is not
+// handled here; use Success or Page.
+func Render(c *gin.Context, format Format, table Table) {
+	var buf bytes.Buffer
+	switch format {
+	case FormatCSV:
+		w := csv.NewWriter(&buf)
+		_ = w.Write(table.Columns)
+		for _, row := range table.Rows {
+			_ = w.Write(row.Values)
+		}
+		w.Flush()
+	case FormatNDJSON:
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		for _, row := range table.Rows {
+			_ = enc.Encode(row.Item)
+		}
+	case FormatMarkdown:
+		if hasText(table) {
+			for i, row := range table.Rows {
+				if i > 0 {
+					buf.WriteString("\n")
+				}
+				for _, line := range row.Text {
+					buf.WriteString("> " + line + "\n>\n")
+				}
+				buf.WriteString("> — " + row.Citation + "\n")
+			}
+		} else {
+			writeMarkdownRow(&buf, table.Columns)
+			buf.WriteString("|" + strings.Repeat(" --- |", len(table.Columns)) + "\n")
+			for _, row := range table.Rows {
+				writeMarkdownRow(&buf, row.Values)
+			}
+		}
+	default:
+		for i, row := range table.Rows {
+			if !hasText(table) {
+				buf.WriteString(strings.Join(row.Values, "\t") + "\n")
+				continue
+			}
+			if i > 0 {
+				buf.WriteString("\n")
+			}
+			for _, line := range row.Text {
+				buf.WriteString(line + "\n")
+			}
+			buf.WriteString("(" + row.Citation + ")\n")
+		}
+	}
+
+	c.Data(http.StatusOK, formatContentTypes[format], buf.Bytes())
+}
+
+func hasText(t Table) bool {
+	return len(t.Rows) > 0 && len(t.Rows[0].Text) > 0
+}
+
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\n", " ")
+
+func writeMarkdownRow(buf *bytes.Buffer, cells []string) {
+	buf.WriteString("|")
+	for _, cell := range cells {
+		buf.WriteString(" " + markdownEscaper.Replace(cell) + " |")
+	}
+	buf.WriteString("\n")
+}
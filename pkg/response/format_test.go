@@ -0,0 +1,90 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestNegotiateFormat(t *testing.T) {
+	tests := []struct {
+		url, accept string
+		want        Format
+		wantErr     bool
+	}{
+		{"/", "", FormatJSON, false},
+		{"/", "text/csv", FormatCSV, false},
+		{"/", "text/markdown, application/json;q=0.5", FormatMarkdown, false},
+		{"/", "application/json, text/plain;q=0.9", FormatJSON, false},
+		{"/", "*/*", FormatJSON, false},
+		{"/", "text/csv;charset=utf-8;q=0.1, text/plain;q=0.5", FormatText, false},
+		{"/", "text/csv; Q=0.2, text/markdown; level=1; q=0.3", FormatMarkdown, false},
+		{"/", "text/csv;q=0", FormatJSON, false},
+		{"/", "text/csv;q=high, text/plain;q=0.1", FormatText, false},
+		{"/?format=ndjson", "text/csv", FormatNDJSON, false},
+		{"/?format=TXT", "", FormatText, false},
+		{"/?format=xml", "", "", true},
+	}
+
+	for _, tc := range tests {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet, tc.url, nil)
+		ctx.Request.Header.Set("Accept", tc.accept)
+
+		got, err := NegotiateFormat(ctx)
+		if (err != nil) != tc.wantErr || got != tc.want {
+			t.Errorf("NegotiateFormat(%s, Accept %q) = %q, %v; want %q", tc.url, tc.accept, got, err, tc.want)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	table := Table{
+		Columns: []string{"id", "text"},
+		Rows: []TableRow{
+			{Values: []string{"1", "a, \"b\""}, Item: map[string]int{"id": 1}, Text: []string{"arab", "terjemah"}, Citation: "QS. Al-Fatihah [1]: 1"},
+			{Values: []string{"2", "c|d"}, Item: map[string]int{"id": 2}, Text: []string{"arab 2", "terjemah 2"}, Citation: "QS. Al-Fatihah [1]: 2"},
+		},
+	}
+
+	tests := []struct {
+		format      Format
+		table       Table
+		contentType string
+		want        string
+	}{
+		{FormatCSV, table, "text/csv; charset=utf-8", "id,text\n1,\"a, \"\"b\"\"\"\n2,c|d\n"},
+		{FormatNDJSON, table, "application/x-ndjson; charset=utf-8", "{\"id\":1}\n{\"id\":2}\n"},
+		{FormatText, table, "text/plain; charset=utf-8", "arab\nterjemah\n(QS. Al-Fatihah [1]: 1)\n\narab 2\nterjemah 2\n(QS. Al-Fatihah [1]: 2)\n"},
+		{FormatMarkdown, Table{Columns: table.Columns, Rows: table.Rows[:1]}, "text/markdown; charset=utf-8", "> arab\n>\n> terjemah\n>\n> — QS. Al-Fatihah [1]: 1\n"},
+		{FormatMarkdown, Table{Columns: []string{"id", "text"}, Rows: []TableRow{{Values: []string{"2", "c|d"}}}}, "text/markdown; charset=utf-8", "| id | text |\n| --- | --- |\n| 2 | c\\|d |\n"},
+	}
+
+	for _, tc := range tests {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+
+		Render(ctx, tc.format, tc.table)
+
+		if got := w.Header().Get("Content-Type"); got != tc.contentType {
+			t.Errorf("%s: Content-Type = %q, want %q", tc.format, got, tc.contentType)
+		}
+		if got := w.Body.String(); got != tc.want {
+			t.Errorf("%s: body =\n%s\nwant\n%s", tc.format, got, tc.want)
+		}
+	}
+}
+
+func TestTableSelect(t *testing.T) {
+	table := Table{Columns: []string{"id", "text", "juz"}, Rows: []TableRow{{Values: []string{"1", "a", "30"}}}}
+
+	got := table.Select([]string{"juz", "id", "unknown"})
+	if len(got.Columns) != 2 || got.Columns[0] != "id" || got.Columns[1] != "juz" {
+		t.Fatalf("unexpected columns %v", got.Columns)
+	}
+	if got.Rows[0].Values[0] != "1" || got.Rows[0].Values[1] != "30" {
+		t.Fatalf("unexpected values %v", got.Rows[0].Values)
+	}
+}
@@ -0,0 +1,77 @@
+package response
+
+// LangKey is the gin context key holding the client's preferred language for
+// error messages ("id" or "en"). It is set by middleware.Language from
+// Accept-Language and by handlers when ?lang names a single language.
+// Without it, which includes an Accept-Language of only "*", error messages
+// stay in English; translations still default to Indonesian.
+const LangKey = "response.lang"
+
+// RequestIDKey is the gin context key holding the request ID, set by
+// middleware.RequestID and echoed in error responses.
+const RequestIDKey = "response.request_id"
+
+// indonesian maps the English error messages (or format strings) used by
+// the handlers to their Indonesian translation. Messages that are not listed
+// are returned unchanged; TestIndonesianCoversEveryMessage fails for any
+// message passed to this package that is missing here.
+var indonesian = map[string]string{
+	"internal server error": "terjadi kesalahan pada server",
+	"route not found":       "rute tidak ditemukan",
+	"surah not found":       "surah tidak ditemukan",
+	"ayah not found":        "ayat tidak ditemukan",
+	"ayahs not found":       "ayat tidak ditemukan",
+	"juz not found":         "juz tidak ditemukan",
+	"invalid request body":  "body request tidak valid",
+	"ids must not be empty": "ids tidak boleh kosong",
+	"lang must be 'id', 'en', a comma-separated list of them, or 'all'": "lang harus 'id', 'en', daftar keduanya dipisah koma, atau 'all'",
+	"date must be YYYY-MM-DD":                                        "date harus berformat YYYY-MM-DD",
+	"unknown theme":                                                  "tema tidak dikenal",
+	"unknown preset":                                                 "preset tidak dikenal",
+	"start must be YYYY-MM-DD":                                       "start harus berformat YYYY-MM-DD",
+	"start is required for this preset":                              "start wajib diisi untuk preset ini",
+	"the dataset lacks data for this plan":                           "dataset belum memuat data untuk rencana ini",
+	"the dataset has no text statistics":                             "dataset belum memuat statistik teks",
+	"invalid url":                                                    "url tidak valid",
+	"invalid %s":                                                     "%s tidak valid",
+	"%s is required":                                                 "%s wajib diisi",
+	"%s is required with %s":                                         "%s wajib diisi bersama %s",
+	"%s must be an integer":                                          "%s harus berupa bilangan bulat",
+	"%s must be true or false":                                       "%s harus true atau false",
+	"%s must be between %d and %d":                                   "%s harus di antara %d dan %d",
+	"%s must be at least %d":                                         "%s paling sedikit %d",
+	"%s must be at most %d":                                          "%s paling banyak %d",
+	"%s must be one of %s":                                           "%s harus salah satu dari %s",
+	"%s must not be less than %s":                                    "%s tidak boleh kurang dari %s",
+	"ids must contain at most %d entries":                            "ids berisi paling banyak %d entri",
+	"invalid ayah id or key %q":                                      "id atau kunci ayat %q tidak valid",
+	"reference covers %d ayahs, maximum is %d":                       "referensi mencakup %d ayat, maksimal %d",
+	"format must be one of json, csv, ndjson, markdown or text":      "format harus salah satu dari json, csv, ndjson, markdown atau text",
+	"style must be one of kemenag, short, apa, chicago or turabian":  "style harus salah satu dari kemenag, short, apa, chicago atau turabian",
+	"invalid field %q":                                               "field %q tidak valid",
+	"unknown field %q":                                               "field %q tidak dikenal",
+	"include must be a comma-separated list of %s, %s, %s, %s or %s": "include harus berupa daftar dipisah koma dari %s, %s, %s, %s atau %s",
+	"include %q is not available in this dataset":                    "include %q tidak tersedia pada dataset ini",
+	"include %q does not apply to this endpoint":                     "include %q tidak berlaku untuk endpoint ini",
+	"unknown time zone %q":                                           "zona waktu %q tidak dikenal",
+	"url is not a shared ayah link":                                  "url bukan tautan ayat yang dibagikan",
+	"only the json format is supported":                              "hanya format json yang didukung",
+	"invalid verse reference: empty reference":                       "referensi ayat tidak valid: referensi kosong",
+	"invalid verse reference: cannot parse %q":                       "referensi ayat tidak valid: %q tidak dapat dibaca",
+	"invalid verse reference: numbers start at 1 in %q":              "referensi ayat tidak valid: nomor dimulai dari 1 pada %q",
+	"invalid verse reference: unknown surah %q":                      "referensi ayat tidak valid: surah %q tidak dikenal",
+	"invalid verse reference: surah %d does not exist: %q":           "referensi ayat tidak valid: surah %d tidak ada: %q",
+	"invalid verse reference: surah %d has %d ayahs: %q":             "referensi ayat tidak valid: surah %d memiliki %d ayat: %q",
+	"invalid verse reference: range end is before its start: %q":     "referensi ayat tidak valid: akhir rentang sebelum awalnya: %q",
+}
+
+// localise translates message into the language stored under LangKey and
+// reports the language of the returned text.
+func localise(lang, message string) (string, string) {
+	if lang == "id" {
+		if translated, ok := indonesian[message]; ok {
+			return translated, "id"
+		}
+	}
+	return message, "en"
+}
@@ -0,0 +1,146 @@
+package response
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// messageArgs maps the functions that take a localised message (or format)
+// to the position of that argument.
+var messageArgs = map[string]int{
+	"NotFound":           2, // response.NotFound(c, code, message, ...)
+	"BadRequest":         2, // response.BadRequest(c, code, message, ...)
+	"BadRequestf":        3, // response.BadRequestf(c, code, details, format, ...)
+	"NotImplemented":     1, // response.NotImplemented(c, message, ...)
+	"ServiceUnavailable": 2, // response.ServiceUnavailable(c, code, message, ...)
+	"localiseMessage":    1, // localiseMessage(c, message)
+	"paramErrorf":        2, // handler: paramErrorf(code, details, format, ...)
+	"newFieldError":      3, // validator: newFieldError(param, rule, code, format, ...)
+	"invalidf":           0, // reference: invalidf(format, ...)
+}
+
+// sourceMessages collects the message literals passed to the functions in
+// messageArgs across the module's internal and pkg trees, keyed by message
+// with the position of one call site. Messages built with fmt.Sprintf are
+// reported as errors: their format would never match the catalogue.
+func sourceMessages(t *testing.T) map[string]string {
+	t.Helper()
+	fset := token.NewFileSet()
+	files := map[string][]*ast.File{} // by directory
+	for _, root := range []string{"../../internal", "../../pkg"} {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+				return err
+			}
+			f, err := parser.ParseFile(fset, path, nil, 0)
+			if err != nil {
+				return err
+			}
+			files[filepath.Dir(path)] = append(files[filepath.Dir(path)], f)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("walking %s: %v", root, err)
+		}
+	}
+
+	messages := map[string]string{}
+	for _, pkg := range files {
+		consts := map[string]string{}
+		for _, f := range pkg {
+			for _, decl := range f.Decls {
+				if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.CONST {
+					for _, spec := range gen.Specs {
+						vs := spec.(*ast.ValueSpec)
+						for i, name := range vs.Names {
+							if i < len(vs.Values) {
+								if s, ok := stringLit(vs.Values[i]); ok {
+									consts[name.Name] = s
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+
+		for _, f := range pkg {
+			ast.Inspect(f, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				var name string
+				switch fn := call.Fun.(type) {
+				case *ast.Ident:
+					name = fn.Name
+				case *ast.SelectorExpr:
+					name = fn.Sel.Name
+				}
+				i, ok := messageArgs[name]
+				if !ok || i >= len(call.Args) {
+					return true
+				}
+				pos := fset.Position(call.Pos()).String()
+				switch arg := call.Args[i].(type) {
+				case *ast.BasicLit:
+					if s, ok := stringLit(arg); ok {
+						messages[s] = pos
+					}
+				case *ast.Ident:
+					if s, ok := consts[arg.Name]; ok {
+						messages[s] = pos
+					}
+				case *ast.CallExpr:
+					if sel, ok := arg.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Sprintf" {
+						t.Errorf("%s: %s message is built with fmt.Sprintf; pass the format instead", pos, name)
+					}
+				}
+				return true
+			})
+		}
+	}
+	return messages
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+func TestIndonesianCoversEveryMessage(t *testing.T) {
+	messages := sourceMessages(t)
+	if len(messages) < 20 {
+		t.Fatalf("found only %d messages; is the call-site walk still matching?", len(messages))
+	}
+	messages[ErrInvalidFormat.Error()] = "ErrInvalidFormat"
+
+	for _, message := range slices.Sorted(maps.Keys(messages)) {
+		if _, ok := indonesian[message]; !ok {
+			t.Errorf("%s: %q has no Indonesian translation", messages[message], message)
+		}
+	}
+}
+
+var verbRe = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+func TestIndonesianKeepsFormatVerbs(t *testing.T) {
+	for en, id := range indonesian {
+		if got, want := verbRe.FindAllString(id, -1), verbRe.FindAllString(en, -1); !slices.Equal(got, want) {
+			t.Errorf("%q: translation has verbs %v, want %v", en, got, want)
+		}
+	}
+}
@@ -4,19 +4,35 @@ package response
 //
 // Response formats:
 //   Success:  { "data": any, "timestamp": string }
-//   Error:    { "error": string, "code": string, "timestamp": string }
+//   Page:     { "data": any, "meta": pagination.Meta, "timestamp": string }
+//   Error:    { "error": string, "code": string, "details": object,
+//               "request_id": string, "timestamp": string }
 //
 // Usage:
 //   response.Success(c, data)
-//   response.NotFound(c, "surah not found")
-//   response.BadRequest(c, "invalid lang")
+//   response.Page(c, data, pagination.NewMeta(params, total))
+//   response.NotFound(c, domain.CodeSurahNotFound, "surah not found")
+//   response.BadRequest(c, domain.CodeInvalidTheme, "unknown theme", response.OneOf("theme", "light", "dark"))
+//   response.BadRequestf(c, domain.CodeInvalidAyahID, response.Param("ids"), "invalid ayah id or key %q", key)
 //   response.InternalError(c)
+//
+// code is one of the domain.Code constants and never changes for a given
+// failure; details names the offending parameter and what it accepts.
+// Error messages are localised into Indonesian when the request prefers it
+// (see LangKey) and Content-Language is set to the language of the message.
+// Clients sending Accept: application/problem+json get RFC 7807 documents
+// with the same members as extensions.
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/pkg/pagination"
 )
 
 func Success(c *gin.Context, data any) {
@@ -28,31 +44,115 @@ func Success(c *gin.Context, data any) {
 	c.JSON(http.StatusOK, success)
 }
 
-func NotFound(c *gin.Context, message string) {
-	error := gin.H{
-		"error":     message,
-		"code":      "not found",
+// Page writes one page of a list with its pagination meta block and an
+// RFC 8288 Link header pointing at the first, previous, next and last pages.
+func Page(c *gin.Context, data any, meta pagination.Meta) {
+	if link := meta.Link(c.Request.URL); link != "" {
+		c.Writer.Header().Add("Link", link) // may join a successor-version link
+	}
+
+	page := gin.H{
+		"data":      data,
+		"meta":      meta,
 		"timestamp": time.Now().UTC().Format(time.RFC3339),
 	}
 
-	c.JSON(http.StatusNotFound, error)
+	c.JSON(http.StatusOK, page)
 }
 
-func BadRequest(c *gin.Context, message string) {
-	error := gin.H{
-		"error":     message,
-		"code":      "bad request",
-		"timestamp": time.Now().UTC().Format(time.RFC3339),
-	}
+// Details describes what was wrong with the request, e.g.
+// {"param": "id", "min": 1, "max": 114}.
+type Details map[string]any
+
+// Param names the offending request parameter.
+func Param(name string) Details {
+	return Details{"param": name}
+}
+
+// Range names a numeric parameter and its inclusive bounds.
+func Range(name string, min, max int) Details {
+	return Details{"param": name, "min": min, "max": max}
+}
+
+// OneOf names a parameter and the values it accepts.
+func OneOf(name string, allowed ...string) Details {
+	return Details{"param": name, "allowed": allowed}
+}
+
+func NotFound(c *gin.Context, code domain.Code, message string, details ...Details) {
+	Error(c, http.StatusNotFound, code, localiseMessage(c, message), details...)
+}
 
-	c.JSON(http.StatusBadRequest, error)
+func BadRequest(c *gin.Context, code domain.Code, message string, details ...Details) {
+	Error(c, http.StatusBadRequest, code, localiseMessage(c, message), details...)
+}
+
+// BadRequestf is BadRequest with a format string. The format, not the
+// formatted result, is looked up for localisation. details may be nil.
+func BadRequestf(c *gin.Context, code domain.Code, details Details, format string, args ...any) {
+	Error(c, http.StatusBadRequest, code, fmt.Sprintf(localiseMessage(c, format), args...), details)
+}
+
+func NotImplemented(c *gin.Context, message string, details ...Details) {
+	Error(c, http.StatusNotImplemented, domain.CodeNotImplemented, localiseMessage(c, message), details...)
+}
+
+// ServiceUnavailable is for requests the API supports but cannot serve from
+// the data it has, such as a dataset seeded without some tables.
+func ServiceUnavailable(c *gin.Context, code domain.Code, message string, details ...Details) {
+	Error(c, http.StatusServiceUnavailable, code, localiseMessage(c, message), details...)
 }
 
 func InternalError(c *gin.Context) {
-	error := gin.H{
-		"code":      "internal server error",
-		"timestamp": time.Now().UTC().Format(time.RFC3339),
+	Error(c, http.StatusInternalServerError, domain.CodeInternal, localiseMessage(c, "internal server error"))
+}
+
+// Error writes an error response with an already localised message. Prefer
+// the status-specific helpers above.
+func Error(c *gin.Context, status int, code domain.Code, message string, details ...Details) {
+	var merged Details
+	for _, d := range details {
+		for k, v := range d {
+			if merged == nil {
+				merged = Details{}
+			}
+			merged[k] = v
+		}
+	}
+	requestID := c.GetString(RequestIDKey)
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+
+	if wantsProblem(c) {
+		c.Render(status, problemRender{ProblemResponse{
+			Type:      "about:blank",
+			Title:     http.StatusText(status),
+			Status:    status,
+			Detail:    message,
+			Instance:  c.Request.URL.Path,
+			Code:      code,
+			Details:   merged,
+			RequestID: requestID,
+			Timestamp: timestamp,
+		}})
+		return
 	}
 
-	c.JSON(http.StatusInternalServerError, error)
+	c.JSON(status, ErrorResponse{
+		Error:     message,
+		Code:      code,
+		Details:   merged,
+		RequestID: requestID,
+		Timestamp: timestamp,
+	})
+}
+
+// wantsProblem reports whether the client asked for RFC 7807 errors.
+func wantsProblem(c *gin.Context) bool {
+	return c.Request != nil && strings.Contains(c.GetHeader("Accept"), problemContentType)
+}
+
+func localiseMessage(c *gin.Context, message string) string {
+	message, lang := localise(c.GetString(LangKey), message)
+	c.Header("Content-Language", lang)
+	return message
 }
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+
+	"quran-api-go/internal/domain"
 )
 
 type bodyTimestamp struct {
@@ -52,7 +54,7 @@ func TestNotFoundResponse(t *testing.T) {
 
 	msg := "surah not found"
 
-	NotFound(ctx, msg)
+	NotFound(ctx, domain.CodeSurahNotFound, msg)
 
 	if w.Code != http.StatusNotFound {
 		t.Logf("expected 404, got %d", w.Code)
@@ -61,6 +63,7 @@ func TestNotFoundResponse(t *testing.T) {
 
 	var body struct {
 		Error string `json:"error"`
+		Code  string `json:"code"`
 		bodyTimestamp
 	}
 
@@ -72,6 +75,9 @@ func TestNotFoundResponse(t *testing.T) {
 		t.Logf("expected %s,surah got %s", msg, body.Error)
 		t.Fail()
 	}
+	if body.Code != "SURAH_NOT_FOUND" {
+		t.Errorf("expected code SURAH_NOT_FOUND, got %q", body.Code)
+	}
 
 	testTimestamp(t, body.Timestamp)
 }
@@ -82,7 +88,7 @@ func TestBadRequestResponse(t *testing.T) {
 
 	msg := "invalid lang"
 
-	BadRequest(ctx, msg)
+	BadRequest(ctx, domain.CodeInvalidLang, msg, OneOf("lang", "id", "en"))
 
 	if w.Code != http.StatusBadRequest {
 		t.Logf("expected 400, got %d", w.Code)
@@ -90,7 +96,9 @@ func TestBadRequestResponse(t *testing.T) {
 	}
 
 	var body struct {
-		Error string `json:"error"`
+		Error   string         `json:"error"`
+		Code    string         `json:"code"`
+		Details map[string]any `json:"details"`
 		bodyTimestamp
 	}
 
@@ -102,6 +110,9 @@ func TestBadRequestResponse(t *testing.T) {
 		t.Logf("expected %s,surah got %s", msg, body.Error)
 		t.Fail()
 	}
+	if body.Code != "INVALID_LANG" || body.Details["param"] != "lang" || len(body.Details["allowed"].([]any)) != 2 {
+		t.Errorf("unexpected code or details: %s %v", body.Code, body.Details)
+	}
 
 	testTimestamp(t, body.Timestamp)
 }
@@ -110,6 +121,8 @@ func TestInternalErrorResponse(t *testing.T) {
 	w := httptest.NewRecorder()
 	ctx, _ := gin.CreateTestContext(w)
 
+	ctx.Set(RequestIDKey, "req-1")
+
 	InternalError(ctx)
 
 	if w.Code != http.StatusInternalServerError {
@@ -117,12 +130,69 @@ func TestInternalErrorResponse(t *testing.T) {
 	}
 
 	var body struct {
+		Error     string `json:"error"`
+		Code      string `json:"code"`
+		RequestID string `json:"request_id"`
 		bodyTimestamp
 	}
 
 	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
 		t.Logf("invalid json %v", err)
 	}
+	if body.Error != "internal server error" || body.Code != "INTERNAL_ERROR" || body.RequestID != "req-1" {
+		t.Errorf("unexpected body %+v", body)
+	}
 
 	testTimestamp(t, body.Timestamp)
 }
+
+func TestLocalisedErrorResponse(t *testing.T) {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Set(LangKey, "id")
+
+	NotFound(ctx, domain.CodeSurahNotFound, "surah not found")
+	BadRequestf(ctx, domain.CodeTooManyIDs, nil, "ids must contain at most %d entries", 100)
+
+	if got := w.Header().Get("Content-Language"); got != "id" {
+		t.Fatalf("expected Content-Language id, got %q", got)
+	}
+
+	dec := json.NewDecoder(w.Body)
+	want := []string{"surah tidak ditemukan", "ids berisi paling banyak 100 entri"}
+	for _, msg := range want {
+		var body struct {
+			Error string `json:"error"`
+		}
+		if err := dec.Decode(&body); err != nil {
+			t.Fatalf("invalid json %v", err)
+		}
+		if body.Error != msg {
+			t.Fatalf("expected %q, got %q", msg, body.Error)
+		}
+	}
+}
+
+func TestProblemResponse(t *testing.T) {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/v1/surah/115", nil)
+	ctx.Request.Header.Set("Accept", "application/problem+json, application/json;q=0.9")
+
+	BadRequest(ctx, domain.CodeInvalidSurahID, "invalid surah id", Range("id", 1, 114))
+
+	if got := w.Header().Get("Content-Type"); got != "application/problem+json; charset=utf-8" {
+		t.Fatalf("Content-Type = %q", got)
+	}
+	var body ProblemResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("invalid json %v", err)
+	}
+	if body.Type != "about:blank" || body.Title != "Bad Request" || body.Status != http.StatusBadRequest ||
+		body.Detail != "invalid surah id" || body.Instance != "/v1/surah/115" || body.Code != domain.CodeInvalidSurahID {
+		t.Errorf("unexpected problem %+v", body)
+	}
+	if body.Details["min"] != float64(1) || body.Details["max"] != float64(114) {
+		t.Errorf("unexpected details %v", body.Details)
+	}
+}
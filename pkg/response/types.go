@@ -1,14 +1,59 @@
 package response
 
+import (
+	"encoding/json"
+	"net/http"
+
+	"quran-api-go/internal/domain"
+	"quran-api-go/pkg/pagination"
+)
+
 // SuccessResponse is the envelope returned for all successful API responses.
 type SuccessResponse struct {
 	Data      interface{} `json:"data"`
 	Timestamp string      `json:"timestamp" example:"2024-01-01T00:00:00Z"`
 }
 
+// PageResponse is the envelope returned for paginated list responses.
+type PageResponse struct {
+	Data      interface{}     `json:"data"`
+	Meta      pagination.Meta `json:"meta"`
+	Timestamp string          `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+}
+
 // ErrorResponse is the envelope returned for all error API responses.
 type ErrorResponse struct {
-	Error     string `json:"error" example:"resource not found"`
-	Code      string `json:"code" example:"not found"`
-	Timestamp string `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+	Error     string      `json:"error" example:"id must be between 1 and 114"`
+	Code      domain.Code `json:"code" swaggertype:"string" example:"INVALID_SURAH_ID"`
+	Details   Details     `json:"details,omitempty" swaggertype:"object"`
+	RequestID string      `json:"request_id,omitempty" example:"9f86d081884c7d65"`
+	Timestamp string      `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+}
+
+const problemContentType = "application/problem+json"
+
+// ProblemResponse is an RFC 7807 problem document, sent instead of
+// ErrorResponse when the client accepts application/problem+json.
+type ProblemResponse struct {
+	Type      string      `json:"type" example:"about:blank"`
+	Title     string      `json:"title" example:"Bad Request"`
+	Status    int         `json:"status" example:"400"`
+	Detail    string      `json:"detail" example:"id must be between 1 and 114"`
+	Instance  string      `json:"instance" example:"/v1/surah/115"`
+	Code      domain.Code `json:"code" swaggertype:"string" example:"INVALID_SURAH_ID"`
+	Details   Details     `json:"details,omitempty" swaggertype:"object"`
+	RequestID string      `json:"request_id,omitempty" example:"9f86d081884c7d65"`
+	Timestamp string      `json:"timestamp" example:"2024-01-01T00:00:00Z"`
+}
+
+// problemRender writes a ProblemResponse with its own media type.
+type problemRender struct{ problem ProblemResponse }
+
+func (r problemRender) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+	return json.NewEncoder(w).Encode(r.problem)
+}
+
+func (r problemRender) WriteContentType(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", problemContentType+"; charset=utf-8")
 }
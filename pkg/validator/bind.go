@@ -0,0 +1,320 @@
+package validator
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"quran-api-go/internal/domain"
+)
+
+// Source supplies raw request parameters. *gin.Context implements it.
+type Source interface {
+	Param(key string) string
+	GetQuery(key string) (string, bool)
+}
+
+// Bind fills the struct pointed to by dst from path and query parameters and
+// validates it. Fields are described with struct tags:
+//
+//	path:"id"           read from the path parameter id
+//	query:"surah_id"    read from the query parameter surah_id
+//	default:"1"         used when the parameter is absent or empty
+//	code:"INVALID_..."  domain.Code reported for this field (INVALID_PARAM)
+//	validate:"..."      comma-separated rules:
+//	    required        the parameter must be present
+//	    min=N, max=N    inclusive bounds for integers
+//	    oneof=a b c     allowed values for strings
+//	    requires=p      when this parameter is given, p must be too
+//	    gtefield=p      this integer must not be less than parameter p
+//
+// Supported field types are int, string, bool and types implementing
+// encoding.TextUnmarshaler; embedded structs are bound recursively.
+// Every invalid field is reported, as Errors.
+//
+// Usage:
+//
+//	var q struct {
+//	    ID int `path:"id" validate:"required,min=1,max=114" code:"INVALID_SURAH_ID"`
+//	}
+//	if err := validator.Bind(c, &q); err != nil {
+//	    // err is validator.Errors
+//	}
+func Bind(src Source, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		panic("validator: Bind needs a pointer to a struct")
+	}
+	b := binder{src: src, fields: map[string]*boundField{}}
+	b.bindStruct(v.Elem())
+	b.checkDependencies()
+	if len(b.errs) > 0 {
+		return b.errs
+	}
+	return nil
+}
+
+// FieldError describes one invalid request parameter. Format and Args hold
+// the unformatted Message so it can be localised. Err is the conversion
+// error behind a "type" failure, such as one from an UnmarshalText.
+type FieldError struct {
+	Param   string      `json:"param"`
+	Rule    string      `json:"rule"`
+	Message string      `json:"message"`
+	Min     *int        `json:"min,omitempty"`
+	Max     *int        `json:"max,omitempty"`
+	Allowed []string    `json:"allowed,omitempty"`
+	Code    domain.Code `json:"-"`
+	Format  string      `json:"-"`
+	Args    []any       `json:"-"`
+	Err     error       `json:"-"`
+}
+
+func (e *FieldError) Error() string { return e.Message }
+
+func (e *FieldError) Unwrap() error { return e.Err }
+
+// Errors lists every invalid parameter of a request, in field order.
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, fe := range e {
+		messages[i] = fe.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e Errors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fe := range e {
+		errs[i] = fe
+	}
+	return errs
+}
+
+// RangeError reports param as outside [min, max], for bounds that depend on
+// data and so cannot be written as tags (an ayah number within its surah).
+func RangeError(param string, code domain.Code, min, max int) error {
+	fe := newFieldError(param, "range", code, "%s must be between %d and %d", param, min, max)
+	fe.Min, fe.Max = &min, &max
+	return Errors{fe}
+}
+
+func newFieldError(param, rule string, code domain.Code, format string, args ...any) *FieldError {
+	return &FieldError{
+		Param:   param,
+		Rule:    rule,
+		Message: fmt.Sprintf(format, args...),
+		Code:    code,
+		Format:  format,
+		Args:    args,
+	}
+}
+
+type rules struct {
+	required bool
+	min, max *int
+	oneof    []string
+	requires []string
+	gtefield string
+}
+
+type boundField struct {
+	value   reflect.Value
+	rules   rules
+	code    domain.Code
+	present bool // given in the request, not defaulted
+	valid   bool
+}
+
+type binder struct {
+	src    Source
+	fields map[string]*boundField
+	order  []string
+	errs   Errors
+}
+
+var textUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+func (b *binder) bindStruct(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			b.bindStruct(v.Field(i))
+			continue
+		}
+
+		var raw, name string
+		var present bool
+		if name = sf.Tag.Get("path"); name != "" {
+			raw = b.src.Param(name)
+		} else if name = sf.Tag.Get("query"); name != "" {
+			raw, _ = b.src.GetQuery(name)
+		} else {
+			continue
+		}
+		present = raw != ""
+
+		f := &boundField{value: v.Field(i), rules: parseRules(sf.Tag.Get("validate")), code: domain.CodeInvalidParam}
+		if code := sf.Tag.Get("code"); code != "" {
+			f.code = domain.Code(code)
+		}
+		f.present = present
+		b.fields[name] = f
+		b.order = append(b.order, name)
+
+		if !present {
+			raw = sf.Tag.Get("default")
+		}
+		if raw == "" {
+			if f.rules.required {
+				b.errs = append(b.errs, newFieldError(name, "required", f.code, "%s is required", name))
+			}
+			continue
+		}
+		if err := setValue(f.value, raw); err != nil {
+			b.errs = append(b.errs, b.typeError(name, f, err))
+			continue
+		}
+		if fe := checkRules(name, f); fe != nil {
+			b.errs = append(b.errs, fe)
+			continue
+		}
+		f.valid = true
+	}
+}
+
+func (b *binder) typeError(name string, f *boundField, err error) *FieldError {
+	var fe *FieldError
+	switch {
+	case f.value.Kind() == reflect.Bool:
+		fe = newFieldError(name, "type", f.code, "%s must be true or false", name)
+	case f.value.Kind() == reflect.Int && !reflect.PointerTo(f.value.Type()).Implements(textUnmarshaler):
+		fe = newFieldError(name, "type", f.code, "%s must be an integer", name)
+	default:
+		fe = newFieldError(name, "type", f.code, "invalid %s", name)
+	}
+	fe.Min, fe.Max, fe.Allowed = f.rules.min, f.rules.max, f.rules.oneof
+	fe.Err = err
+	return fe
+}
+
+func setValue(v reflect.Value, raw string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(raw))
+	}
+	switch v.Kind() {
+	case reflect.Int:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(n))
+	case reflect.Bool:
+		ok, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(ok)
+	case reflect.String:
+		v.SetString(raw)
+	default:
+		panic("validator: unsupported field type " + v.Type().String())
+	}
+	return nil
+}
+
+func checkRules(name string, f *boundField) *FieldError {
+	r := f.rules
+	if f.value.Kind() == reflect.Int && (r.min != nil || r.max != nil) {
+		n := int(f.value.Int())
+		if (r.min != nil && n < *r.min) || (r.max != nil && n > *r.max) {
+			var fe *FieldError
+			switch {
+			case r.min != nil && r.max != nil:
+				fe = newFieldError(name, "range", f.code, "%s must be between %d and %d", name, *r.min, *r.max)
+			case r.min != nil:
+				fe = newFieldError(name, "min", f.code, "%s must be at least %d", name, *r.min)
+			default:
+				fe = newFieldError(name, "max", f.code, "%s must be at most %d", name, *r.max)
+			}
+			fe.Min, fe.Max = r.min, r.max
+			return fe
+		}
+	}
+	if len(r.oneof) > 0 && f.value.Kind() == reflect.String {
+		s := f.value.String()
+		for _, allowed := range r.oneof {
+			if s == allowed {
+				return nil
+			}
+		}
+		fe := newFieldError(name, "oneof", f.code, "%s must be one of %s", name, strings.Join(r.oneof, ", "))
+		fe.Allowed = r.oneof
+		return fe
+	}
+	return nil
+}
+
+// checkDependencies applies the rules that relate two parameters, once
+// every field has been bound.
+func (b *binder) checkDependencies() {
+	for _, name := range b.order {
+		f := b.fields[name]
+		for _, other := range f.rules.requires {
+			if f.present && (b.fields[other] == nil || !b.fields[other].present) {
+				b.errs = append(b.errs, newFieldError(other, "requires", b.fieldCode(other, f.code), "%s is required with %s", other, name))
+			}
+		}
+		if other := b.fields[f.rules.gtefield]; other != nil && f.valid && other.valid {
+			if f.value.Int() < other.value.Int() {
+				fe := newFieldError(name, "gtefield", f.code, "%s must not be less than %s", name, f.rules.gtefield)
+				b.errs = append(b.errs, fe)
+			}
+		}
+	}
+}
+
+func (b *binder) fieldCode(name string, fallback domain.Code) domain.Code {
+	if f := b.fields[name]; f != nil {
+		return f.code
+	}
+	return fallback
+}
+
+func parseRules(tag string) rules {
+	var r rules
+	for _, rule := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		switch key {
+		case "":
+		case "required":
+			r.required = true
+		case "min":
+			r.min = mustAtoi(tag, value)
+		case "max":
+			r.max = mustAtoi(tag, value)
+		case "oneof":
+			r.oneof = strings.Fields(value)
+		case "requires":
+			r.requires = append(r.requires, value)
+		case "gtefield":
+			r.gtefield = value
+		default:
+			panic("validator: unknown rule " + strconv.Quote(key) + " in " + strconv.Quote(tag))
+		}
+	}
+	return r
+}
+
+func mustAtoi(tag, value string) *int {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		panic("validator: bad number in " + strconv.Quote(tag))
+	}
+	return &n
+}
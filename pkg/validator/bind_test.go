@@ -0,0 +1,121 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	"quran-api-go/internal/domain"
+)
+
+type testSource struct {
+	path  map[string]string
+	query map[string]string
+}
+
+func (s testSource) Param(key string) string { return s.path[key] }
+
+func (s testSource) GetQuery(key string) (string, bool) {
+	v, ok := s.query[key]
+	return v, ok
+}
+
+type testPage struct {
+	Page int `query:"page" default:"1" validate:"min=1" code:"INVALID_PAGINATION"`
+}
+
+type testParams struct {
+	ID    int    `path:"id" validate:"required,min=1,max=114" code:"INVALID_SURAH_ID"`
+	Type  string `query:"type" validate:"oneof=meccan medinan"`
+	From  int    `query:"from" validate:"min=1,requires=to"`
+	To    int    `query:"to" validate:"min=1,requires=from,gtefield=from"`
+	Cross bool   `query:"cross" default:"false"`
+	testPage
+}
+
+func TestBind(t *testing.T) {
+	var p testParams
+	src := testSource{path: map[string]string{"id": "2"}, query: map[string]string{"type": "medinan", "from": "3", "to": "5", "cross": "true"}}
+	if err := Bind(src, &p); err != nil {
+		t.Fatalf("Bind: %v", err)
+	}
+	if p.ID != 2 || p.Type != "medinan" || p.From != 3 || p.To != 5 || !p.Cross || p.Page != 1 {
+		t.Errorf("unexpected params %+v", p)
+	}
+}
+
+func TestBindErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  map[string]string
+		query map[string]string
+		want  []string // param:rule of each error, in order
+	}{
+		{"missing required", nil, nil, []string{"id:required"}},
+		{"not an integer", map[string]string{"id": "abc"}, nil, []string{"id:type"}},
+		{"out of range", map[string]string{"id": "115"}, nil, []string{"id:range"}},
+		{"enum", map[string]string{"id": "1"}, map[string]string{"type": "makki"}, []string{"type:oneof"}},
+		{"bool", map[string]string{"id": "1"}, map[string]string{"cross": "maybe"}, []string{"cross:type"}},
+		{"embedded", map[string]string{"id": "1"}, map[string]string{"page": "x"}, []string{"page:type"}},
+		{"requires", map[string]string{"id": "1"}, map[string]string{"from": "3"}, []string{"to:requires"}},
+		{"gtefield", map[string]string{"id": "1"}, map[string]string{"from": "5", "to": "3"}, []string{"to:gtefield"}},
+		{"every field", map[string]string{"id": "0"}, map[string]string{"type": "x", "page": "0"}, []string{"id:range", "type:oneof", "page:min"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var p testParams
+			err := Bind(testSource{path: tc.path, query: tc.query}, &p)
+			var errs Errors
+			if !errors.As(err, &errs) {
+				t.Fatalf("Bind error = %v, want Errors", err)
+			}
+			if len(errs) != len(tc.want) {
+				t.Fatalf("got %d errors (%v), want %v", len(errs), errs, tc.want)
+			}
+			for i, fe := range errs {
+				if got := fe.Param + ":" + fe.Rule; got != tc.want[i] {
+					t.Errorf("error %d = %s (%s), want %s", i, got, fe.Message, tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBindErrorDetails(t *testing.T) {
+	var p testParams
+	err := Bind(testSource{path: map[string]string{"id": "200"}}, &p)
+	var errs Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Bind error = %v", err)
+	}
+	fe := errs[0]
+	if fe.Code != domain.CodeInvalidSurahID || *fe.Min != 1 || *fe.Max != 114 || fe.Message != "id must be between 1 and 114" {
+		t.Errorf("unexpected error %+v", fe)
+	}
+
+	err = RangeError("to", domain.CodeInvalidAyahRange, 3, 7)
+	if !errors.As(err, &errs) || errs[0].Message != "to must be between 3 and 7" || *errs[0].Max != 7 {
+		t.Errorf("RangeError = %v", err)
+	}
+}
+
+var errUnknown = errors.New("unknown")
+
+type testName int
+
+func (n *testName) UnmarshalText(text []byte) error {
+	if string(text) != "one" {
+		return errUnknown
+	}
+	*n = 1
+	return nil
+}
+
+func TestBindKeepsConversionError(t *testing.T) {
+	var p struct {
+		Name testName `path:"name"`
+	}
+	err := Bind(testSource{path: map[string]string{"name": "two"}}, &p)
+	if !errors.Is(err, errUnknown) {
+		t.Errorf("Bind error = %v, want it to wrap the UnmarshalText error", err)
+	}
+}
@@ -8,11 +8,16 @@ package validator
 // Usage:
 //   lang, err := validator.ValidateLang(c.Query("lang"))
 //   if err != nil {
-//       response.BadRequest(c, "lang must be 'id' or 'en'")
+//       response.BadRequest(c, domain.CodeInvalidLang, "lang must be 'id' or 'en'", response.Param("lang"))
 //       return
 //   }
 
-import "quran-api-go/internal/domain"
+import (
+	"strconv"
+	"strings"
+
+	"quran-api-go/internal/domain"
+)
 
 func ValidateLang(lang string) (string, error) {
 	if lang == "" {
@@ -25,3 +30,97 @@ func ValidateLang(lang string) (string, error) {
 
 	return lang, nil
 }
+
+// SupportedLangs lists the translation languages available in the dataset.
+var SupportedLangs = []string{"id", "en"}
+
+// ValidateLangs parses a lang parameter that may request several languages.
+// Returns ["id"] when lang is empty, SupportedLangs for "all", and the
+// deduplicated list for a comma-separated value such as "id,en".
+// Returns domain.ErrInvalidLang if any entry is not supported.
+//
+// Usage:
+//
+//	langs, err := validator.ValidateLangs(c.Query("lang"))
+//	if err != nil {
+//	    response.BadRequest(c, domain.CodeInvalidLang, "lang must be 'id', 'en', a comma-separated list of them, or 'all'", response.OneOf("lang", "id", "en", "all"))
+//	    return
+//	}
+func ValidateLangs(lang string) ([]string, error) {
+	if lang == "all" {
+		return append([]string(nil), SupportedLangs...), nil
+	}
+
+	var langs []string
+	seen := map[string]struct{}{}
+	for _, part := range strings.Split(lang, ",") {
+		l, err := ValidateLang(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[l]; ok {
+			continue
+		}
+		seen[l] = struct{}{}
+		langs = append(langs, l)
+	}
+
+	return langs, nil
+}
+
+// NegotiateLang picks the best supported language for an RFC 7231
+// Accept-Language header, honouring quality values ("en-US;q=0.8, id").
+// Region subtags are ignored and the legacy code "in" counts as Indonesian.
+// Reports false when the header is empty, is only "*" or names no supported
+// language, in which case callers fall back to their default.
+func NegotiateLang(header string) (string, bool) {
+	best, bestQ := "", 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+
+		q, ok := QValue(params)
+		if !ok {
+			continue
+		}
+
+		primary, _, _ := strings.Cut(tag, "-")
+		var lang string
+		switch primary {
+		case "id", "in":
+			lang = "id"
+		case "en":
+			lang = "en"
+		default:
+			// "*" states no preference, so it falls back like a missing header.
+			continue
+		}
+
+		// Equal weights keep the earlier entry, as listed by the client.
+		if q > bestQ {
+			best, bestQ = lang, q
+		}
+	}
+	return best, best != ""
+}
+
+// QValue finds the q parameter among the ";"-separated parameters of an
+// Accept or Accept-Language entry ("charset=utf-8;q=0.5"), defaulting to 1.
+// It reports false for a malformed q.
+func QValue(params string) (float64, bool) {
+	for _, param := range strings.Split(params, ";") {
+		name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if !strings.EqualFold(strings.TrimSpace(name), "q") {
+			continue
+		}
+		q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || q < 0 || q > 1 {
+			return 0, false
+		}
+		return q, true
+	}
+	return 1, true
+}
@@ -1,6 +1,9 @@
 package validator
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestLangValidator(t *testing.T) {
 
@@ -28,3 +31,66 @@ func TestLangValidator(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestLangsValidator(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    []string
+		wantErr bool
+	}{
+		{"", []string{"id"}, false},
+		{"en", []string{"en"}, false},
+		{"id,en", []string{"id", "en"}, false},
+		{"en, id, en", []string{"en", "id"}, false},
+		{"all", []string{"id", "en"}, false},
+		{"id,jp", nil, true},
+		{"id,", []string{"id"}, false},
+	}
+
+	for _, tc := range tests {
+		got, err := ValidateLangs(tc.raw)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("ValidateLangs(%q): expected error", tc.raw)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ValidateLangs(%q): unexpected error %v", tc.raw, err)
+			continue
+		}
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Errorf("ValidateLangs(%q) = %v, want %v", tc.raw, got, tc.want)
+		}
+	}
+}
+
+func TestNegotiateLang(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+		ok     bool
+	}{
+		{"", "", false},
+		{"en", "en", true},
+		{"en-US,en;q=0.9", "en", true},
+		{"id-ID", "id", true},
+		{"in", "id", true},
+		{"fr-FR, en;q=0.5, id;q=0.8", "id", true},
+		{"id;q=0.5, en;q=0.5", "id", true},
+		{"en;q=0, id;q=0.1", "id", true},
+		{"fr, de", "", false},
+		{"*", "", false},
+		{"*, en;q=0.5", "en", true},
+		{"en;q=abc", "", false},
+		{"en-GB;level=1;q=0.4, id;q=0.3", "en", true},
+		{"id;charset=x;q=0.2, en;q=0.3", "en", true},
+	}
+
+	for _, tc := range tests {
+		got, ok := NegotiateLang(tc.header)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("NegotiateLang(%q) = %q, %v; want %q, %v", tc.header, got, ok, tc.want, tc.ok)
+		}
+	}
+}
@@ -14,7 +14,7 @@ import (
 //
 //	lang, err := validator.ValidateIDParam(c.Query("id"))
 //	if err != nil {
-//	    response.BadRequest(c, "invalid param")
+//	    response.BadRequest(c, domain.CodeInvalidParam, "invalid param", response.Param("id"))
 //	    return
 //	}
 func ValidateIDParam(id string) (string, error) {
@@ -40,7 +40,7 @@ func ValidateIDParam(id string) (string, error) {
 //
 //	err := validator.ValidateRangeParam(c.Query("from"), c.Query("to"))
 //	if err != nil {
-//	    response.BadRequest(c, "invalid param")
+//	    response.BadRequest(c, domain.CodeInvalidParam, "invalid param")
 //	    return
 //	}
 func ValidateRangeParam(from, to string) error {
@@ -0,0 +1,444 @@
+// Package fontsubset reduces a TrueType font to the glyphs a script needs
+// and packages it as WOFF. It exists to produce the Arabic font embedded in
+// SVG share cards (internal/handler/static/fonts).
+//
+// Glyph IDs are retained: unwanted glyphs are emptied rather than removed,
+// so GSUB and GPOS (contextual letter forms, mark positioning) keep working
+// without being rewritten. Glyphs that no code point maps to are kept, as
+// they are typically reached through GSUB.
+package fontsubset
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrNotTrueType  = errors.New("not a TrueType font")
+	ErrMissingTable = errors.New("missing required table")
+	ErrNoCmap       = errors.New("no supported cmap subtable")
+)
+
+// Arabic reports whether r belongs to the Arabic blocks (including the
+// Quranic annotation signs and presentation forms), the joiners and the
+// dotted circle used to display isolated marks.
+func Arabic(r rune) bool {
+	switch {
+	case r >= 0x0600 && r <= 0x06FF,
+		r >= 0x0750 && r <= 0x077F,
+		r >= 0x0870 && r <= 0x08FF,
+		r >= 0xFB50 && r <= 0xFDFF,
+		r >= 0xFE70 && r <= 0xFEFF,
+		r >= 0x200C && r <= 0x200F,
+		r == ' ', r == 0x00A0, r == 0x25CC:
+		return true
+	}
+	return false
+}
+
+type table struct {
+	tag  string
+	data []byte
+}
+
+// Subset returns font with the outlines of every glyph reachable only from
+// code points rejected by keep emptied, a cmap limited to the kept code
+// points, glyph names dropped (post version 3) and DSIG removed.
+func Subset(font []byte, keep func(rune) bool) ([]byte, error) {
+	tables, err := parseSFNT(font)
+	if err != nil {
+		return nil, err
+	}
+	byTag := map[string][]byte{}
+	for _, t := range tables {
+		byTag[t.tag] = t.data
+	}
+	for _, tag := range []string{"head", "maxp", "cmap", "loca", "glyf"} {
+		if byTag[tag] == nil {
+			return nil, fmt.Errorf("%w: %s", ErrMissingTable, tag)
+		}
+	}
+
+	numGlyphs := int(binary.BigEndian.Uint16(byTag["maxp"][4:]))
+	longLoca := binary.BigEndian.Uint16(byTag["head"][50:]) == 1
+	offsets, err := parseLoca(byTag["loca"], numGlyphs, longLoca)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := parseCmap(byTag["cmap"])
+	if err != nil {
+		return nil, err
+	}
+
+	// Start from every glyph, drop those only rejected code points map to.
+	encodedKept := map[uint16]bool{}
+	encodedDropped := map[uint16]bool{}
+	kept := map[rune]uint16{}
+	for r, gid := range mapping {
+		if keep(r) {
+			encodedKept[gid] = true
+			kept[r] = gid
+		} else {
+			encodedDropped[gid] = true
+		}
+	}
+	keepGlyph := make([]bool, numGlyphs)
+	for gid := range keepGlyph {
+		keepGlyph[gid] = gid == 0 || encodedKept[uint16(gid)] || !encodedDropped[uint16(gid)]
+	}
+	glyf := byTag["glyf"]
+	for changed := true; changed; {
+		changed = false
+		for gid, ok := range keepGlyph {
+			if !ok {
+				continue
+			}
+			for _, component := range components(glyf[offsets[gid]:offsets[gid+1]]) {
+				if int(component) < numGlyphs && !keepGlyph[component] {
+					keepGlyph[component] = true
+					changed = true
+				}
+			}
+		}
+	}
+
+	var newGlyf bytes.Buffer
+	newOffsets := make([]int, numGlyphs+1)
+	for gid := 0; gid < numGlyphs; gid++ {
+		newOffsets[gid] = newGlyf.Len()
+		if keepGlyph[gid] {
+			newGlyf.Write(glyf[offsets[gid]:offsets[gid+1]])
+			for newGlyf.Len()%4 != 0 {
+				newGlyf.WriteByte(0)
+			}
+		}
+	}
+	newOffsets[numGlyphs] = newGlyf.Len()
+
+	head := append([]byte(nil), byTag["head"]...)
+	binary.BigEndian.PutUint32(head[8:], 0) // checkSumAdjustment, set below
+	binary.BigEndian.PutUint16(head[50:], 1)
+
+	var out []table
+	for _, t := range tables {
+		switch t.tag {
+		case "DSIG", "hdmx", "LTSH", "VDMX":
+			continue
+		case "glyf":
+			t.data = newGlyf.Bytes()
+		case "loca":
+			t.data = writeLoca(newOffsets)
+		case "cmap":
+			t.data = writeCmap(kept)
+		case "head":
+			t.data = head
+		case "post":
+			post := append([]byte(nil), t.data[:32]...)
+			binary.BigEndian.PutUint32(post, 0x00030000)
+			t.data = post
+		}
+		out = append(out, t)
+	}
+
+	sfnt := writeSFNT(binary.BigEndian.Uint32(font), out)
+	headOffset := tableOffset(sfnt, "head")
+	binary.BigEndian.PutUint32(sfnt[headOffset+8:], 0xB1B0AFBA-checksum(sfnt))
+	return sfnt, nil
+}
+
+// WOFF wraps an sfnt font in WOFF 1.0, zlib-compressing each table where
+// that makes it smaller.
+func WOFF(sfnt []byte) ([]byte, error) {
+	tables, err := parseSFNT(sfnt)
+	if err != nil {
+		return nil, err
+	}
+
+	const headerSize, entrySize = 44, 20
+	dirEnd := headerSize + entrySize*len(tables)
+	var header, dir, data bytes.Buffer
+	totalSfntSize := 12 + 16*len(tables)
+	for _, t := range tables {
+		compressed := t.data
+		var zbuf bytes.Buffer
+		zw, _ := zlib.NewWriterLevel(&zbuf, zlib.BestCompression)
+		_, _ = zw.Write(t.data)
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		if zbuf.Len() < len(t.data) {
+			compressed = zbuf.Bytes()
+		}
+
+		dir.WriteString(t.tag)
+		_ = binary.Write(&dir, binary.BigEndian, []uint32{
+			uint32(dirEnd + data.Len()),
+			uint32(len(compressed)),
+			uint32(len(t.data)),
+			checksum(t.data),
+		})
+		data.Write(compressed)
+		for data.Len()%4 != 0 {
+			data.WriteByte(0)
+		}
+		totalSfntSize += pad4(len(t.data))
+	}
+
+	header.WriteString("wOFF")
+	_ = binary.Write(&header, binary.BigEndian, binary.BigEndian.Uint32(sfnt))
+	_ = binary.Write(&header, binary.BigEndian, uint32(dirEnd+data.Len()))
+	_ = binary.Write(&header, binary.BigEndian, []uint16{uint16(len(tables)), 0})
+	_ = binary.Write(&header, binary.BigEndian, uint32(totalSfntSize))
+	_ = binary.Write(&header, binary.BigEndian, []uint16{1, 0})
+	_ = binary.Write(&header, binary.BigEndian, make([]uint32, 5)) // no metadata or private data
+
+	return append(append(header.Bytes(), dir.Bytes()...), data.Bytes()...), nil
+}
+
+func parseSFNT(font []byte) ([]table, error) {
+	if len(font) < 12 {
+		return nil, ErrNotTrueType
+	}
+	if version := binary.BigEndian.Uint32(font); version != 0x00010000 && version != 0x74727565 {
+		return nil, ErrNotTrueType
+	}
+	numTables := int(binary.BigEndian.Uint16(font[4:]))
+	if len(font) < 12+16*numTables {
+		return nil, ErrNotTrueType
+	}
+	tables := make([]table, 0, numTables)
+	for i := 0; i < numTables; i++ {
+		record := font[12+16*i:]
+		offset := int(binary.BigEndian.Uint32(record[8:]))
+		length := int(binary.BigEndian.Uint32(record[12:]))
+		if offset+length > len(font) {
+			return nil, fmt.Errorf("%w: table %q out of bounds", ErrNotTrueType, record[:4])
+		}
+		tables = append(tables, table{tag: string(record[:4]), data: font[offset : offset+length]})
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].tag < tables[j].tag })
+	return tables, nil
+}
+
+func writeSFNT(version uint32, tables []table) []byte {
+	var buf bytes.Buffer
+	n := len(tables)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= n {
+		entrySelector++
+	}
+	searchRange := (1 << entrySelector) * 16
+	_ = binary.Write(&buf, binary.BigEndian, version)
+	_ = binary.Write(&buf, binary.BigEndian, []uint16{uint16(n), uint16(searchRange), uint16(entrySelector), uint16(n*16 - searchRange)})
+
+	offset := 12 + 16*n
+	for _, t := range tables {
+		buf.WriteString(t.tag)
+		_ = binary.Write(&buf, binary.BigEndian, []uint32{checksum(t.data), uint32(offset), uint32(len(t.data))})
+		offset += pad4(len(t.data))
+	}
+	for _, t := range tables {
+		buf.Write(t.data)
+		for buf.Len()%4 != 0 {
+			buf.WriteByte(0)
+		}
+	}
+	return buf.Bytes()
+}
+
+func tableOffset(sfnt []byte, tag string) int {
+	numTables := int(binary.BigEndian.Uint16(sfnt[4:]))
+	for i := 0; i < numTables; i++ {
+		record := sfnt[12+16*i:]
+		if string(record[:4]) == tag {
+			return int(binary.BigEndian.Uint32(record[8:]))
+		}
+	}
+	return -1
+}
+
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+func pad4(n int) int { return (n + 3) &^ 3 }
+
+func parseLoca(loca []byte, numGlyphs int, long bool) ([]int, error) {
+	offsets := make([]int, numGlyphs+1)
+	for i := range offsets {
+		if long {
+			if len(loca) < 4*(i+1) {
+				return nil, fmt.Errorf("%w: short loca", ErrNotTrueType)
+			}
+			offsets[i] = int(binary.BigEndian.Uint32(loca[4*i:]))
+		} else {
+			if len(loca) < 2*(i+1) {
+				return nil, fmt.Errorf("%w: short loca", ErrNotTrueType)
+			}
+			offsets[i] = 2 * int(binary.BigEndian.Uint16(loca[2*i:]))
+		}
+	}
+	return offsets, nil
+}
+
+func writeLoca(offsets []int) []byte {
+	loca := make([]byte, 4*len(offsets))
+	for i, offset := range offsets {
+		binary.BigEndian.PutUint32(loca[4*i:], uint32(offset))
+	}
+	return loca
+}
+
+// components lists the glyphs a composite glyph is built from.
+func components(glyph []byte) []uint16 {
+	if len(glyph) < 10 || int16(binary.BigEndian.Uint16(glyph)) >= 0 {
+		return nil
+	}
+	const (
+		argsAreWords    = 0x0001
+		haveScale       = 0x0008
+		moreComponents  = 0x0020
+		haveXYScale     = 0x0040
+		haveTwoByTwo    = 0x0080
+		componentHeader = 4
+	)
+	var gids []uint16
+	for p := 10; p+componentHeader <= len(glyph); {
+		flags := binary.BigEndian.Uint16(glyph[p:])
+		gids = append(gids, binary.BigEndian.Uint16(glyph[p+2:]))
+		p += componentHeader
+		if flags&argsAreWords != 0 {
+			p += 4
+		} else {
+			p += 2
+		}
+		switch {
+		case flags&haveScale != 0:
+			p += 2
+		case flags&haveXYScale != 0:
+			p += 4
+		case flags&haveTwoByTwo != 0:
+			p += 8
+		}
+		if flags&moreComponents == 0 {
+			break
+		}
+	}
+	return gids
+}
+
+// parseCmap reads the Unicode mapping from a (3,10) format 12 or (3,1)
+// format 4 subtable.
+func parseCmap(cmap []byte) (map[rune]uint16, error) {
+	numTables := int(binary.BigEndian.Uint16(cmap[2:]))
+	var format4, format12 []byte
+	for i := 0; i < numTables; i++ {
+		record := cmap[4+8*i:]
+		platform, encoding := binary.BigEndian.Uint16(record), binary.BigEndian.Uint16(record[2:])
+		sub := cmap[binary.BigEndian.Uint32(record[4:]):]
+		switch {
+		case platform == 3 && encoding == 10 && binary.BigEndian.Uint16(sub) == 12:
+			format12 = sub
+		case platform == 3 && encoding == 1 && binary.BigEndian.Uint16(sub) == 4:
+			format4 = sub
+		}
+	}
+
+	mapping := map[rune]uint16{}
+	switch {
+	case format12 != nil:
+		groups := int(binary.BigEndian.Uint32(format12[12:]))
+		for i := 0; i < groups; i++ {
+			g := format12[16+12*i:]
+			start, end, gid := binary.BigEndian.Uint32(g), binary.BigEndian.Uint32(g[4:]), binary.BigEndian.Uint32(g[8:])
+			for r := start; r <= end; r++ {
+				mapping[rune(r)] = uint16(gid + r - start)
+			}
+		}
+	case format4 != nil:
+		segments := int(binary.BigEndian.Uint16(format4[6:])) / 2
+		ends := format4[14:]
+		starts := ends[2*segments+2:]
+		deltas := starts[2*segments:]
+		rangeOffsets := deltas[2*segments:]
+		for s := 0; s < segments; s++ {
+			start, end := binary.BigEndian.Uint16(starts[2*s:]), binary.BigEndian.Uint16(ends[2*s:])
+			delta := binary.BigEndian.Uint16(deltas[2*s:])
+			rangeOffset := int(binary.BigEndian.Uint16(rangeOffsets[2*s:]))
+			for r := int(start); r <= int(end) && r != 0xFFFF; r++ {
+				gid := uint16(r) + delta
+				if rangeOffset != 0 {
+					at := 2*s + rangeOffset + 2*(r-int(start))
+					if gid = binary.BigEndian.Uint16(rangeOffsets[at:]); gid != 0 {
+						gid += delta
+					}
+				}
+				if gid != 0 {
+					mapping[rune(r)] = gid
+				}
+			}
+		}
+	default:
+		return nil, ErrNoCmap
+	}
+	return mapping, nil
+}
+
+// writeCmap writes a (3,1) format 4 cmap for BMP mappings, one segment per
+// run of consecutive code points and glyph IDs.
+func writeCmap(mapping map[rune]uint16) []byte {
+	runes := make([]rune, 0, len(mapping))
+	for r := range mapping {
+		if r <= 0xFFFE {
+			runes = append(runes, r)
+		}
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	type segment struct{ start, end, delta uint16 }
+	var segments []segment
+	for _, r := range runes {
+		gid := mapping[r]
+		if n := len(segments); n > 0 && segments[n-1].end+1 == uint16(r) && uint16(r)+segments[n-1].delta == gid {
+			segments[n-1].end = uint16(r)
+			continue
+		}
+		segments = append(segments, segment{uint16(r), uint16(r), gid - uint16(r)})
+	}
+	segments = append(segments, segment{0xFFFF, 0xFFFF, 1})
+
+	n := len(segments)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= n {
+		entrySelector++
+	}
+	searchRange := 2 * (1 << entrySelector)
+	length := 16 + 8*n
+
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.BigEndian, []uint16{0, 1, 3, 1})
+	_ = binary.Write(&buf, binary.BigEndian, uint32(12))
+	_ = binary.Write(&buf, binary.BigEndian, []uint16{4, uint16(length), 0, uint16(2 * n), uint16(searchRange), uint16(entrySelector), uint16(2*n - searchRange)})
+	for _, s := range segments {
+		_ = binary.Write(&buf, binary.BigEndian, s.end)
+	}
+	_ = binary.Write(&buf, binary.BigEndian, uint16(0))
+	for _, s := range segments {
+		_ = binary.Write(&buf, binary.BigEndian, s.start)
+	}
+	for _, s := range segments {
+		_ = binary.Write(&buf, binary.BigEndian, s.delta)
+	}
+	_ = binary.Write(&buf, binary.BigEndian, make([]uint16, n)) // idRangeOffset
+	return buf.Bytes()
+}
@@ -0,0 +1,267 @@
+package fontsubset
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"testing"
+)
+
+// Glyphs of testFont: .notdef, "A", beh (a composite of glyph 3), the dot
+// glyph 3 and glyph 4, which no code point maps to (as if reached via GSUB).
+const (
+	gidNotdef = iota
+	gidLatinA
+	gidBeh
+	gidDot
+	gidUnencoded
+	numTestGlyphs
+)
+
+func simpleGlyph(marker byte) []byte {
+	return []byte{0, 1, 0, 0, 0, 0, 0, 10, 0, 10, marker, marker}
+}
+
+func compositeGlyph(component uint16) []byte {
+	g := []byte{0xFF, 0xFF, 0, 0, 0, 0, 0, 10, 0, 10}
+	g = binary.BigEndian.AppendUint16(g, 0) // flags: byte args, last component
+	g = binary.BigEndian.AppendUint16(g, component)
+	return append(g, 0, 0)
+}
+
+// testFont builds a minimal TrueType font with a short loca and a format 4
+// cmap, the layout Subset has to rewrite.
+func testFont(t *testing.T) []byte {
+	t.Helper()
+	glyphs := [][]byte{
+		simpleGlyph(0xA0),
+		simpleGlyph(0xA1),
+		compositeGlyph(gidDot),
+		simpleGlyph(0xA3),
+		simpleGlyph(0xA4),
+	}
+	var glyf bytes.Buffer
+	var loca []byte
+	for _, g := range glyphs {
+		loca = binary.BigEndian.AppendUint16(loca, uint16(glyf.Len()/2))
+		glyf.Write(g)
+		for glyf.Len()%4 != 0 {
+			glyf.WriteByte(0)
+		}
+	}
+	loca = binary.BigEndian.AppendUint16(loca, uint16(glyf.Len()/2))
+
+	head := make([]byte, 54)
+	binary.BigEndian.PutUint32(head, 0x00010000)
+	binary.BigEndian.PutUint32(head[12:], 0x5F0F3CF5)
+	maxp := make([]byte, 6)
+	binary.BigEndian.PutUint32(maxp, 0x00005000)
+	binary.BigEndian.PutUint16(maxp[4:], numTestGlyphs)
+	post := make([]byte, 40)
+	binary.BigEndian.PutUint32(post, 0x00020000)
+
+	return writeSFNT(0x00010000, []table{
+		{"DSIG", []byte{0, 0, 0, 1, 0, 0, 0, 0}},
+		{"cmap", writeCmap(map[rune]uint16{'A': gidLatinA, 'ب': gidBeh})},
+		{"glyf", glyf.Bytes()},
+		{"head", head},
+		{"loca", loca},
+		{"maxp", maxp},
+		{"post", post},
+	})
+}
+
+func glyphs(t *testing.T, sfnt []byte) [][]byte {
+	t.Helper()
+	tables := tablesByTag(t, sfnt)
+	numGlyphs := int(binary.BigEndian.Uint16(tables["maxp"][4:]))
+	long := binary.BigEndian.Uint16(tables["head"][50:]) == 1
+	offsets, err := parseLoca(tables["loca"], numGlyphs, long)
+	if err != nil {
+		t.Fatalf("parseLoca: %v", err)
+	}
+	out := make([][]byte, numGlyphs)
+	for gid := range out {
+		out[gid] = tables["glyf"][offsets[gid]:offsets[gid+1]]
+	}
+	return out
+}
+
+func tablesByTag(t *testing.T, sfnt []byte) map[string][]byte {
+	t.Helper()
+	tables, err := parseSFNT(sfnt)
+	if err != nil {
+		t.Fatalf("parseSFNT: %v", err)
+	}
+	byTag := map[string][]byte{}
+	for _, tb := range tables {
+		byTag[tb.tag] = tb.data
+	}
+	return byTag
+}
+
+// checkChecksums verifies every table record's checksum and the head
+// checkSumAdjustment, which makes the whole font sum to 0xB1B0AFBA.
+func checkChecksums(t *testing.T, sfnt []byte) {
+	t.Helper()
+	numTables := int(binary.BigEndian.Uint16(sfnt[4:]))
+	for i := 0; i < numTables; i++ {
+		record := sfnt[12+16*i:]
+		tag := string(record[:4])
+		offset, length := binary.BigEndian.Uint32(record[8:]), binary.BigEndian.Uint32(record[12:])
+		data := append([]byte(nil), sfnt[offset:offset+length]...)
+		if tag == "head" {
+			binary.BigEndian.PutUint32(data[8:], 0)
+		}
+		if got, want := checksum(data), binary.BigEndian.Uint32(record[4:]); got != want {
+			t.Errorf("%s checksum = %#x, record says %#x", tag, got, want)
+		}
+	}
+	if sum := checksum(sfnt); sum != 0xB1B0AFBA {
+		t.Errorf("font checksum = %#x, want 0xB1B0AFBA", sum)
+	}
+}
+
+func TestSubset(t *testing.T) {
+	font := testFont(t)
+	sfnt, err := Subset(font, Arabic)
+	if err != nil {
+		t.Fatalf("Subset: %v", err)
+	}
+	checkChecksums(t, sfnt)
+
+	tables := tablesByTag(t, sfnt)
+	if _, ok := tables["DSIG"]; ok {
+		t.Error("expected DSIG to be dropped")
+	}
+	if v := binary.BigEndian.Uint32(tables["post"]); v != 0x00030000 || len(tables["post"]) != 32 {
+		t.Errorf("expected post version 3 without glyph names, got %#x (%d bytes)", v, len(tables["post"]))
+	}
+
+	mapping, err := parseCmap(tables["cmap"])
+	if err != nil {
+		t.Fatalf("parseCmap: %v", err)
+	}
+	if len(mapping) != 1 || mapping['ب'] != gidBeh {
+		t.Errorf("expected cmap to map only beh, got %v", mapping)
+	}
+
+	before, after := glyphs(t, font), glyphs(t, sfnt)
+	if len(after) != numTestGlyphs {
+		t.Fatalf("expected glyph IDs to be retained, got %d glyphs", len(after))
+	}
+	for gid, want := range map[int]bool{gidNotdef: true, gidLatinA: false, gidBeh: true, gidDot: true, gidUnencoded: true} {
+		kept := bytes.Equal(after[gid], before[gid])
+		if kept != want || (!want && len(after[gid]) != 0) {
+			t.Errorf("glyph %d: kept = %v (%d bytes), want %v", gid, kept, len(after[gid]), want)
+		}
+	}
+}
+
+func TestSubsetErrors(t *testing.T) {
+	if _, err := Subset([]byte("OTTO0000000000"), Arabic); !errors.Is(err, ErrNotTrueType) {
+		t.Errorf("CFF font: got %v, want ErrNotTrueType", err)
+	}
+	noGlyf := writeSFNT(0x00010000, []table{{"head", make([]byte, 54)}})
+	if _, err := Subset(noGlyf, Arabic); !errors.Is(err, ErrMissingTable) {
+		t.Errorf("missing tables: got %v, want ErrMissingTable", err)
+	}
+}
+
+// unwrapWOFF decodes a WOFF 1.0 file back to its tables, checking each
+// against the lengths and checksum of its directory entry.
+func unwrapWOFF(t *testing.T, woff []byte) map[string][]byte {
+	t.Helper()
+	if string(woff[:4]) != "wOFF" {
+		t.Fatalf("signature = %q, want wOFF", woff[:4])
+	}
+	if length := binary.BigEndian.Uint32(woff[8:]); int(length) != len(woff) {
+		t.Errorf("header length = %d, file is %d bytes", length, len(woff))
+	}
+	numTables := int(binary.BigEndian.Uint16(woff[12:]))
+	tables := map[string][]byte{}
+	for i := 0; i < numTables; i++ {
+		entry := woff[44+20*i:]
+		tag := string(entry[:4])
+		offset, compLength := binary.BigEndian.Uint32(entry[4:]), binary.BigEndian.Uint32(entry[8:])
+		origLength, origChecksum := binary.BigEndian.Uint32(entry[12:]), binary.BigEndian.Uint32(entry[16:])
+		data := woff[offset : offset+compLength]
+		if compLength < origLength {
+			zr, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("%s: %v", tag, err)
+			}
+			if data, err = io.ReadAll(zr); err != nil {
+				t.Fatalf("%s: %v", tag, err)
+			}
+		}
+		if uint32(len(data)) != origLength {
+			t.Errorf("%s: %d bytes, directory says %d", tag, len(data), origLength)
+		}
+		if got := checksum(data); got != origChecksum {
+			t.Errorf("%s: checksum %#x, directory says %#x", tag, got, origChecksum)
+		}
+		tables[tag] = data
+	}
+	return tables
+}
+
+func TestWOFFRoundTrip(t *testing.T) {
+	sfnt, err := Subset(testFont(t), Arabic)
+	if err != nil {
+		t.Fatalf("Subset: %v", err)
+	}
+	woff, err := WOFF(sfnt)
+	if err != nil {
+		t.Fatalf("WOFF: %v", err)
+	}
+
+	want := tablesByTag(t, sfnt)
+	got := unwrapWOFF(t, woff)
+	if len(got) != len(want) {
+		t.Fatalf("got %d tables, want %d", len(got), len(want))
+	}
+	for tag, data := range want {
+		if !bytes.Equal(got[tag], data) {
+			t.Errorf("%s differs after the round trip", tag)
+		}
+	}
+}
+
+// TestShippedFont checks the font committed for share cards covers the
+// Arabic of the mushaf, Quranic marks included.
+func TestShippedFont(t *testing.T) {
+	woff, err := os.ReadFile("../../internal/handler/static/fonts/amiri-arabic.woff")
+	if err != nil {
+		t.Skipf("shipped font not found: %v", err)
+	}
+	tables := unwrapWOFF(t, woff)
+	mapping, err := parseCmap(tables["cmap"])
+	if err != nil {
+		t.Fatalf("parseCmap: %v", err)
+	}
+	numGlyphs := int(binary.BigEndian.Uint16(tables["maxp"][4:]))
+	offsets, err := parseLoca(tables["loca"], numGlyphs, binary.BigEndian.Uint16(tables["head"][50:]) == 1)
+	if err != nil {
+		t.Fatalf("parseLoca: %v", err)
+	}
+
+	for _, r := range "بِسْمِ ٱللَّهِ ٱلرَّحْمَٰنِ ٱلرَّحِيمِ ذَٰلِكَ ٱلْكِتَٰبُ لَا رَيْبَ ۛ فِيهِ ۛ هُدًى لِّلْمُتَّقِينَ ۝" {
+		gid, ok := mapping[r]
+		if !ok {
+			t.Errorf("%U is not in the cmap", r)
+			continue
+		}
+		if r != ' ' && offsets[gid] == offsets[gid+1] {
+			t.Errorf("%U maps to the empty glyph %d", r, gid)
+		}
+	}
+	for _, r := range "Az09" {
+		if _, ok := mapping[r]; ok {
+			t.Errorf("expected %q to be subset away", r)
+		}
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"path/filepath"
 
 	"github.com/rs/zerolog/log"
+
+	"quran-api-go/pkg/arabic"
 )
 
 type Surah struct {
@@ -35,6 +37,10 @@ type JuzMeta struct {
 
 type MetaData struct {
 	Juzs JuzsMeta `json:"juzs"`
+	// Rukus and Pages list where each ruku and each Madani mushaf page
+	// starts, in the same shape as Juzs.
+	Rukus JuzsMeta `json:"rukus"`
+	Pages JuzsMeta `json:"pages"`
 }
 
 type JuzsMeta struct {
@@ -55,6 +61,12 @@ type FlatAyah struct {
 	TranslationID  string
 	TranslationEN  string
 	JuzNumber      int
+	PageNumber     int
+	RukuNumber     int
+	WordCount      int
+	LetterCount    int
+	CharCount      int
+	RecitationMS   int
 	SajdaType      sql.NullString
 	RevelationType string
 }
@@ -120,6 +132,9 @@ func Run(ctx context.Context, db *sql.DB, dataDir string) error {
 	if err := seedJuzs(ctx, tx, juzs); err != nil {
 		return err
 	}
+	if err := seedStats(ctx, tx, flatAyahs); err != nil {
+		return err
+	}
 
 	if err := validateCounts(ctx, tx, len(idSurahs), len(flatAyahs), len(juzs)); err != nil {
 		return err
@@ -213,6 +228,10 @@ func buildAyahsAndJuzs(idSurahs, enSurahs []Surah, meta JuzMeta) ([]FlatAyah, []
 				TextUthmani:    idS.Verses[vIdx].Text,
 				TranslationID:  idS.Verses[vIdx].Translation,
 				TranslationEN:  enS.Verses[vIdx].Translation,
+				WordCount:      len(arabic.Words(idS.Verses[vIdx].Text)),
+				LetterCount:    arabic.Letters(idS.Verses[vIdx].Text),
+				CharCount:      arabic.Characters(idS.Verses[vIdx].Text),
+				RecitationMS:   int(arabic.RecitationTime(idS.Verses[vIdx].Text).Milliseconds()),
 				RevelationType: idS.RevelationType,
 				SajdaType:      sql.NullString{},
 			})
@@ -254,9 +273,41 @@ func buildAyahsAndJuzs(idSurahs, enSurahs []Surah, meta JuzMeta) ([]FlatAyah, []
 		flat[i].JuzNumber = juzs[juzIdx].JuzNumber
 	}
 
+	if err := assignDivision(flat, "rukus", meta.Data.Rukus.References, func(a *FlatAyah, n int) { a.RukuNumber = n }); err != nil {
+		return nil, nil, err
+	}
+	if err := assignDivision(flat, "pages", meta.Data.Pages.References, func(a *FlatAyah, n int) { a.PageNumber = n }); err != nil {
+		return nil, nil, err
+	}
+
 	return flat, juzs, nil
 }
 
+// assignDivision numbers every ayah with the division (ruku, page) it falls
+// in, given where each division starts. Older meta files without the
+// division are accepted and leave the numbers at 0.
+func assignDivision(flat []FlatAyah, name string, starts []JuzReference, set func(a *FlatAyah, n int)) error {
+	if len(starts) == 0 {
+		log.Warn().Str("division", name).Msg("meta has no references; leaving numbers empty")
+		return nil
+	}
+	if starts[0].Surah != 1 || starts[0].Ayah != 1 {
+		return fmt.Errorf("first of %s starts at %d:%d, not 1:1", name, starts[0].Surah, starts[0].Ayah)
+	}
+
+	n := 0
+	for i := range flat {
+		if n < len(starts) && flat[i].SurahID == starts[n].Surah && flat[i].NumberInSurah == starts[n].Ayah {
+			n++
+		}
+		set(&flat[i], n)
+	}
+	if n != len(starts) {
+		return fmt.Errorf("only %d of %d %s starts found", n, len(starts), name)
+	}
+	return nil
+}
+
 func seedSurahs(ctx context.Context, tx *sql.Tx, surahs []Surah) error {
 	stmt, err := tx.PrepareContext(ctx, `
 		INSERT OR REPLACE INTO surahs (
@@ -281,8 +332,9 @@ func seedSurahs(ctx context.Context, tx *sql.Tx, surahs []Surah) error {
 func seedAyahs(ctx context.Context, tx *sql.Tx, ayahs []FlatAyah) error {
 	stmt, err := tx.PrepareContext(ctx, `
 		INSERT OR REPLACE INTO ayahs (
-			id, surah_id, number_in_surah, text_uthmani, translation_indo, translation_en, juz_number, sajda_type, revelation_type
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+			id, surah_id, number_in_surah, text_uthmani, translation_indo, translation_en, juz_number,
+			page_number, ruku_number, word_count, letter_count, char_count, recitation_ms, sajda_type, revelation_type
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`)
 	if err != nil {
 		return err
@@ -303,7 +355,8 @@ func seedAyahs(ctx context.Context, tx *sql.Tx, ayahs []FlatAyah) error {
 		if a.SajdaType.Valid {
 			sajda = a.SajdaType.String
 		}
-		if _, err := stmt.ExecContext(ctx, a.ID, a.SurahID, a.NumberInSurah, a.TextUthmani, a.TranslationID, a.TranslationEN, a.JuzNumber, sajda, a.RevelationType); err != nil {
+		if _, err := stmt.ExecContext(ctx, a.ID, a.SurahID, a.NumberInSurah, a.TextUthmani, a.TranslationID, a.TranslationEN, a.JuzNumber,
+			a.PageNumber, a.RukuNumber, a.WordCount, a.LetterCount, a.CharCount, a.RecitationMS, sajda, a.RevelationType); err != nil {
 			return err
 		}
 		if _, err := ftsStmt.ExecContext(ctx, a.ID, a.TextUthmani, a.TranslationID, a.TranslationEN); err != nil {
@@ -335,6 +388,73 @@ func seedJuzs(ctx context.Context, tx *sql.Tx, juzs []Juz) error {
 	return nil
 }
 
+// StatsRow is the text statistics of one stretch of the mushaf.
+type StatsRow struct {
+	Scope         string
+	Number        int
+	FirstAyah     int
+	LastAyah      int
+	Ayahs         int
+	Words         int
+	Letters       int
+	Characters    int
+	RecitationMS  int
+	LettersBefore int
+}
+
+// buildStats sums the per-ayah statistics for the mushaf, every surah and
+// every juz.
+func buildStats(ayahs []FlatAyah) []StatsRow {
+	type key struct {
+		scope  string
+		number int
+	}
+	var rows []StatsRow
+	index := map[key]int{}
+	letters := 0
+	for _, a := range ayahs {
+		for _, k := range []key{{"mushaf", 0}, {"surah", a.SurahID}, {"juz", a.JuzNumber}} {
+			i, ok := index[k]
+			if !ok {
+				i = len(rows)
+				index[k] = i
+				rows = append(rows, StatsRow{Scope: k.scope, Number: k.number, FirstAyah: a.ID, LettersBefore: letters})
+			}
+			r := &rows[i]
+			r.LastAyah = a.ID
+			r.Ayahs++
+			r.Words += a.WordCount
+			r.Letters += a.LetterCount
+			r.Characters += a.CharCount
+			r.RecitationMS += a.RecitationMS
+		}
+		letters += a.LetterCount
+	}
+	return rows
+}
+
+func seedStats(ctx context.Context, tx *sql.Tx, ayahs []FlatAyah) error {
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT OR REPLACE INTO stats (
+			scope, number, first_ayah_id, last_ayah_id, ayahs, words, letters, characters, recitation_ms, letters_before
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	rows := buildStats(ayahs)
+	for _, r := range rows {
+		if _, err := stmt.ExecContext(ctx, r.Scope, r.Number, r.FirstAyah, r.LastAyah, r.Ayahs, r.Words, r.Letters, r.Characters, r.RecitationMS, r.LettersBefore); err != nil {
+			return err
+		}
+	}
+
+	log.Info().Int("count", len(rows)).Msg("stats seeded")
+	return nil
+}
+
 func validateCounts(ctx context.Context, tx *sql.Tx, surahCount, ayahCount, juzCount int) error {
 	var gotSurah, gotAyah, gotJuz int
 